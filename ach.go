@@ -0,0 +1,160 @@
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package adyen
+
+import "strings"
+
+// PaymentMethodACH is the payment method type of ACH Direct Debit.
+const PaymentMethodACH = "ach"
+
+const (
+	// ErrBankAccountNumberInvalid is used if the bank account number is not valid.
+	ErrBankAccountNumberInvalid ErrorCode = "ach.accountNumberField.invalid"
+
+	// ErrBankLocationIDInvalid is used if the routing number is not valid.
+	ErrBankLocationIDInvalid ErrorCode = "ach.accountLocationField.invalid"
+)
+
+const (
+	// minBankAccountNumberLength and maxBankAccountNumberLength are the
+	// lengths that a US bank account number can have.
+	minBankAccountNumberLength = 4
+	maxBankAccountNumberLength = 17
+
+	// routingNumberLength is the length of an ABA routing number.
+	routingNumberLength = 9
+)
+
+// A BankAccountType is the type of US bank account.
+type BankAccountType string
+
+const (
+	// BankAccountChecking is a checking account.
+	BankAccountChecking BankAccountType = "checking"
+
+	// BankAccountSavings is a savings account.
+	BankAccountSavings BankAccountType = "savings"
+)
+
+// An ACHPaymentMethod is the "paymentMethod" object for an ACH Direct Debit payment.
+type ACHPaymentMethod struct {
+	// Type is always PaymentMethodACH.
+	Type string `json:"type"`
+
+	EncryptedBankAccountNumber string `json:"encryptedBankAccountNumber"`
+	EncryptedBankLocationID    string `json:"encryptedBankLocationId"`
+
+	// OwnerName is the name of the bank account owner.
+	OwnerName string `json:"ownerName"`
+
+	// BankAccountType is the optional type of the bank account.
+	BankAccountType BankAccountType `json:"bankAccountType,omitempty"`
+}
+
+// ValidateBankAccountNumber validates that a US bank account number
+// consists of 4 to 17 digits.
+//
+// If err != nil, it is a *ValidationError.
+func ValidateBankAccountNumber(accountNumber string) error {
+	switch l := len(accountNumber); {
+	case l == 0:
+		return &ValidationError{Field: KeyBankAccountNumber, Code: ErrFieldIncomplete}
+	case l < minBankAccountNumberLength || l > maxBankAccountNumberLength || !isDigits(accountNumber):
+		return &ValidationError{Field: KeyBankAccountNumber, Code: ErrBankAccountNumberInvalid}
+	}
+	return nil
+}
+
+// ValidateRoutingNumber validates an ABA routing number, which must consist
+// of 9 digits, not all zero, and pass the ABA checksum. Its first two digits
+// must be in one of the ranges the ABA assigns: 00 to 12 (Federal Reserve
+// districts), 21 to 32 (thrifts), 61 to 72 (electronic transactions) or 80
+// (traveler's checks).
+//
+// If err != nil, it is a *ValidationError.
+func ValidateRoutingNumber(routingNumber string) error {
+	switch {
+	case routingNumber == "":
+		return &ValidationError{Field: KeyBankLocationID, Code: ErrFieldIncomplete}
+	case len(routingNumber) != routingNumberLength || !isDigits(routingNumber) ||
+		strings.Trim(routingNumber, "0") == "" || !routingPrefixValid(routingNumber) || !abaValid(routingNumber):
+		return &ValidationError{Field: KeyBankLocationID, Code: ErrBankLocationIDInvalid}
+	}
+	return nil
+}
+
+// routingPrefixValid reports whether the first two digits of the routing
+// number are in one of the ranges that the ABA assigns.
+func routingPrefixValid(routingNumber string) bool {
+	prefix := int(routingNumber[0]-'0')*10 + int(routingNumber[1]-'0')
+	return prefix <= 12 || (prefix >= 21 && prefix <= 32) || (prefix >= 61 && prefix <= 72) || prefix == 80
+}
+
+// abaValid reports whether the 9-digit routing number passes the ABA checksum,
+// which weighs the digits 3, 7, 1, 3, 7, 1, 3, 7, 1.
+func abaValid(routingNumber string) bool {
+	sum := 0
+	for i := 0; i < len(routingNumber); i++ {
+		sum += int(routingNumber[i]-'0') * [...]int{3, 7, 1}[i%3]
+	}
+	return sum%10 == 0
+}
+
+// EncryptACH validates and encrypts a US bank account number and routing number
+// and returns the payment method to send to Adyen. Both numbers are normalized
+// like NormalizeCardNumber does first.
+//
+// The owner name is required, but the bank account type is optional
+// and can be left empty.
+func (enc *Encrypter) EncryptACH(accountNumber, routingNumber, ownerName string, accountType BankAccountType) (*ACHPaymentMethod, error) {
+	if digits, ok := normalizeDigits(accountNumber); ok {
+		accountNumber = digits
+	}
+	if digits, ok := normalizeDigits(routingNumber); ok {
+		routingNumber = digits
+	}
+
+	if err := ValidateBankAccountNumber(accountNumber); err != nil {
+		return nil, err
+	}
+	if err := ValidateRoutingNumber(routingNumber); err != nil {
+		return nil, err
+	}
+
+	if ownerName = strings.TrimSpace(ownerName); ownerName == "" {
+		return nil, &ValidationError{Field: "ownerName", Code: ErrFieldIncomplete}
+	}
+
+	pm := NewACHPaymentMethod("", "", ownerName, accountType)
+
+	var err error
+	if pm.EncryptedBankAccountNumber, err = enc.EncryptField(KeyBankAccountNumber, accountNumber); err != nil {
+		return nil, err
+	}
+	if pm.EncryptedBankLocationID, err = enc.EncryptField(KeyBankLocationID, routingNumber); err != nil {
+		return nil, err
+	}
+	return pm, nil
+}
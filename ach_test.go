@@ -0,0 +1,104 @@
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package adyen
+
+import "testing"
+
+func TestValidateRoutingNumber(t *testing.T) {
+	test := func(routingNumber string, expectedCode ErrorCode) {
+		if code := errorCode(t, ValidateRoutingNumber(routingNumber)); code != expectedCode {
+			t.Fatalf("%s should have code %q, instead got %q\n", routingNumber, expectedCode, code)
+		}
+	}
+
+	test("011000015", "")
+	test("121000358", "")
+	test("021000021", "")
+	test("001000009", "")
+	test("211000006", "")
+	test("321000006", "")
+	test("611000004", "")
+	test("601000001", ErrBankLocationIDInvalid)
+	test("721000004", "")
+	test("801000005", "")
+	test("000000000", ErrBankLocationIDInvalid)
+	test("131000005", ErrBankLocationIDInvalid)
+	test("201000003", ErrBankLocationIDInvalid)
+	test("331000009", ErrBankLocationIDInvalid)
+	test("731000007", ErrBankLocationIDInvalid)
+	test("811000008", ErrBankLocationIDInvalid)
+	test("901000002", ErrBankLocationIDInvalid)
+	test("011000016", ErrBankLocationIDInvalid)
+	test("01100001", ErrBankLocationIDInvalid)
+	test("01100001a", ErrBankLocationIDInvalid)
+	test("", ErrFieldIncomplete)
+}
+
+func TestValidateBankAccountNumber(t *testing.T) {
+	test := func(accountNumber string, valid bool) {
+		if err := ValidateBankAccountNumber(accountNumber); (err == nil) != valid {
+			t.Fatalf("%s should be valid: %t, instead got %v\n", accountNumber, valid, err)
+		}
+	}
+
+	test("1234", true)
+	test("12345678901234567", true)
+	test("123", false)
+	test("123456789012345678", false)
+	test("1234a", false)
+	test("", false)
+}
+
+func TestEncrypter_EncryptACH(t *testing.T) {
+	enc, key := newTestEncrypter(t)
+
+	pm, err := enc.EncryptACH("1234 5678 9", "011-000-015", "John Smith", BankAccountChecking)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if fields := decrypt(t, key, pm.EncryptedBankAccountNumber); fields[KeyBankAccountNumber] != "123456789" {
+		t.Fatalf("unexpected fields %v\n", fields)
+	}
+	if fields := decrypt(t, key, pm.EncryptedBankLocationID); fields[KeyBankLocationID] != "011000015" {
+		t.Fatalf("unexpected fields %v\n", fields)
+	}
+
+	m := marshalFields(t, pm)
+	if m["type"] != "ach" || m["ownerName"] != "John Smith" || m["bankAccountType"] != "checking" ||
+		m["encryptedBankAccountNumber"] == "" || m["encryptedBankLocationId"] == "" {
+		t.Fatalf("unexpected fields %v\n", m)
+	}
+
+	if _, err = enc.EncryptACH("123456789", "011000016", "John Smith", ""); err == nil {
+		t.Fatal("expected an error for an invalid routing number")
+	}
+	if _, err = enc.EncryptACH("123456789", "011000015", " ", ""); err == nil {
+		t.Fatal("expected an error for an empty owner name")
+	}
+	if _, err = enc.EncryptACH("12x", "011000015", "John Smith", ""); err == nil {
+		t.Fatal("expected an error for an invalid account number")
+	}
+}
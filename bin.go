@@ -0,0 +1,316 @@
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package adyen
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// A FundingSource is how a card is funded.
+type FundingSource string
+
+const (
+	// FundingCredit is used for credit cards.
+	FundingCredit FundingSource = "credit"
+
+	// FundingDebit is used for debit cards.
+	FundingDebit FundingSource = "debit"
+
+	// FundingPrepaid is used for prepaid cards.
+	FundingPrepaid FundingSource = "prepaid"
+)
+
+// A BINRecord describes the cards that have a certain BIN (bank identification
+// number), which is the first 6 or 8 digits of the card number.
+type BINRecord struct {
+	// BIN is the 6 or 8 digit prefix of the cards.
+	BIN string `json:"bin"`
+
+	// Country is the ISO 3166-1 alpha-2 code of the issuer's country.
+	Country string `json:"country"`
+
+	// FundingSource is how the cards are funded, or empty if it is not known.
+	FundingSource FundingSource `json:"fundingSource"`
+
+	// Category is the card category, like "consumer" or "commercial".
+	Category string `json:"category"`
+
+	// Issuer is the name of the issuing bank, if known.
+	Issuer string `json:"issuer,omitempty"`
+
+	// Brand is the brand detected by DetectCardType for the card number
+	// that was looked up. It is not read from the data.
+	Brand string `json:"-"`
+}
+
+// ParseBINCSV parses BIN records from CSV data.
+//
+// The first row must be a header with the columns "bin", "country",
+// "funding_source", "category" and "issuer" in any order.
+// Only the "bin" column is required.
+func ParseBINCSV(r io.Reader) ([]BINRecord, error) {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	index := make(map[string]int, len(header))
+	for i, name := range header {
+		index[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	if _, ok := index["bin"]; !ok {
+		return nil, errors.New("adyen: BIN data has no bin column")
+	}
+
+	var records []BINRecord
+	for {
+		row, err := cr.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		column := func(name string) string {
+			if i, ok := index[name]; ok && i < len(row) {
+				return strings.TrimSpace(row[i])
+			}
+			return ""
+		}
+
+		records = append(records, BINRecord{
+			BIN:           column("bin"),
+			Country:       column("country"),
+			FundingSource: FundingSource(strings.ToLower(column("funding_source"))),
+			Category:      column("category"),
+			Issuer:        column("issuer"),
+		})
+	}
+	return records, nil
+}
+
+// ParseBINJSON parses BIN records from a JSON array of BINRecord objects.
+func ParseBINJSON(r io.Reader) ([]BINRecord, error) {
+	var records []BINRecord
+	if err := json.NewDecoder(r).Decode(&records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// A BINDatabase looks up BIN records by card number.
+// It is safe for concurrent use.
+type BINDatabase struct {
+	mu      sync.RWMutex
+	records map[string]BINRecord
+
+	// path and modTime are set if the database was opened from a file.
+	path    string
+	modTime time.Time
+}
+
+// NewBINDatabase creates a new BINDatabase from records.
+//
+// An error is returned if a BIN does not have 6 or 8 digits, if a BIN
+// is given twice or if a funding source is not one of FundingCredit,
+// FundingDebit and FundingPrepaid. Funding sources are not case-sensitive.
+func NewBINDatabase(records []BINRecord) (*BINDatabase, error) {
+	db := new(BINDatabase)
+	if err := db.Replace(records); err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
+// OpenBINDatabase creates a new BINDatabase from a CSV or JSON file, depending
+// on whether its extension is ".csv" or ".json".
+//
+// The file can be read again with Reload or Watch.
+func OpenBINDatabase(path string) (*BINDatabase, error) {
+	db := &BINDatabase{path: path}
+	if err := db.Reload(); err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
+// Replace replaces all records in db with records.
+// The records are checked like NewBINDatabase does.
+// If err != nil, db is not changed.
+func (db *BINDatabase) Replace(records []BINRecord) error {
+	m := make(map[string]BINRecord, len(records))
+	for _, record := range records {
+		if l := len(record.BIN); (l != 6 && l != 8) || !isDigits(record.BIN) {
+			return fmt.Errorf("adyen: invalid BIN %q", record.BIN)
+		}
+		if _, ok := m[record.BIN]; ok {
+			return fmt.Errorf("adyen: duplicate BIN %s", record.BIN)
+		}
+
+		record.FundingSource = FundingSource(strings.ToLower(strings.TrimSpace(string(record.FundingSource))))
+		switch record.FundingSource {
+		case "", FundingCredit, FundingDebit, FundingPrepaid:
+		default:
+			return fmt.Errorf("adyen: BIN %s has invalid funding source %q", record.BIN, record.FundingSource)
+		}
+		m[record.BIN] = record
+	}
+
+	db.mu.Lock()
+	db.records = m
+	db.mu.Unlock()
+	return nil
+}
+
+// Reload reads the file that db was opened from again.
+// If err != nil, db is not changed.
+func (db *BINDatabase) Reload() error {
+	if db.path == "" {
+		return errors.New("adyen: BIN database was not opened from a file")
+	}
+
+	f, err := os.Open(db.path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	var records []BINRecord
+	switch ext := strings.ToLower(filepath.Ext(db.path)); ext {
+	case ".csv":
+		records, err = ParseBINCSV(f)
+	case ".json":
+		records, err = ParseBINJSON(f)
+	default:
+		return fmt.Errorf("adyen: unknown BIN data format %q", ext)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err = db.Replace(records); err != nil {
+		return err
+	}
+
+	db.mu.Lock()
+	db.modTime = info.ModTime()
+	db.mu.Unlock()
+	return nil
+}
+
+// Watch checks the file that db was opened from every interval and reloads it
+// when its modification time changes, until ctx is done.
+//
+// Errors from checking or reloading the file are passed to onError if it is
+// not nil, and the previous records are kept. Watch blocks, so it is usually
+// run in its own goroutine. It returns ctx.Err() once ctx is done.
+//
+// An error is returned right away if interval is not positive
+// or if db was not opened from a file.
+func (db *BINDatabase) Watch(ctx context.Context, interval time.Duration, onError func(error)) error {
+	if interval <= 0 {
+		return fmt.Errorf("adyen: non-positive interval %v for BIN database watch", interval)
+	}
+	if db.path == "" {
+		return errors.New("adyen: BIN database was not opened from a file")
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		info, err := os.Stat(db.path)
+		if err == nil {
+			db.mu.RLock()
+			changed := !info.ModTime().Equal(db.modTime)
+			db.mu.RUnlock()
+
+			if !changed {
+				continue
+			}
+			err = db.Reload()
+		}
+
+		if err != nil && onError != nil {
+			onError(err)
+		}
+	}
+}
+
+// Lookup looks up the record with the longest BIN that the card number
+// begins with. The card number is normalized with NormalizeCardNumber first.
+//
+// The record's Brand is always set to the brand detected by DetectCardType,
+// even if no record was found.
+func (db *BINDatabase) Lookup(number string) (record BINRecord, ok bool) {
+	number, err := NormalizeCardNumber(number)
+	if err != nil {
+		return BINRecord{Brand: NoBrand}, false
+	}
+
+	db.mu.RLock()
+	for _, l := range [...]int{8, 6} {
+		if len(number) >= l {
+			if record, ok = db.records[number[:l]]; ok {
+				break
+			}
+		}
+	}
+	db.mu.RUnlock()
+
+	record.Brand = DetectCardType(number)
+	return
+}
+
+// Len returns the number of records in db.
+func (db *BINDatabase) Len() int {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	return len(db.records)
+}
@@ -0,0 +1,168 @@
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package adyen
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testBINCSV = `bin,country,funding_source,category,issuer
+512345,US,credit,consumer,Test Bank
+51234590,NL,Debit,commercial,
+400018,GB,prepaid,consumer,Other Bank
+`
+
+func TestParseBINCSV(t *testing.T) {
+	records, err := ParseBINCSV(strings.NewReader(testBINCSV))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 3 {
+		t.Fatalf("expected 3 records, got %d\n", len(records))
+	}
+	if r := records[1]; r.BIN != "51234590" || r.Country != "NL" || r.FundingSource != FundingDebit || r.Category != "commercial" {
+		t.Fatalf("unexpected record %+v\n", r)
+	}
+
+	if _, err = ParseBINCSV(strings.NewReader("country\nUS\n")); err == nil {
+		t.Fatal("expected an error without a bin column")
+	}
+}
+
+func TestBINDatabase_Lookup(t *testing.T) {
+	records, err := ParseBINJSON(strings.NewReader(`[
+		{"bin": "512345", "country": "US", "fundingSource": "credit", "category": "consumer"},
+		{"bin": "51234590", "country": "NL", "fundingSource": "Debit", "category": "commercial"},
+		{"bin": "400018", "country": "GB", "category": "consumer"}
+	]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := NewBINDatabase(records)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	test := func(number, expectedBIN, expectedBrand string) {
+		record, ok := db.Lookup(number)
+		if ok != (expectedBIN != "") || record.BIN != expectedBIN || record.Brand != expectedBrand {
+			t.Fatalf("%s should be (%s, %s), instead got %+v\n", number, expectedBIN, expectedBrand, record)
+		}
+	}
+
+	if record, _ := db.Lookup("5123459046058920"); record.FundingSource != FundingDebit {
+		t.Fatalf("unexpected funding source %q\n", record.FundingSource)
+	}
+
+	test("5123 4590 4605 8920", "51234590", "mc")
+	test("5123451234567890", "512345", "mc")
+	test("4000180000000002", "400018", "visa")
+	test("4111111111111111", "", "visa")
+	test("51234", "", "mc")
+	test("not a number", "", NoBrand)
+
+	for _, bin := range []string{"5123", "5123459", "51234x", "512345"} {
+		if _, err = NewBINDatabase(append(records, BINRecord{BIN: bin})); err == nil {
+			t.Fatalf("expected an error for BIN %s\n", bin)
+		}
+	}
+
+	for _, funding := range []FundingSource{"charge", "credit card", "-"} {
+		if _, err = NewBINDatabase([]BINRecord{{BIN: "411111", FundingSource: funding}}); err == nil {
+			t.Fatalf("expected an error for funding source %q\n", funding)
+		}
+	}
+}
+
+func TestBINDatabase_Watch(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bins.csv")
+	if err := os.WriteFile(path, []byte(testBINCSV), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := OpenBINDatabase(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if db.Len() != 3 {
+		t.Fatalf("expected 3 records, got %d\n", db.Len())
+	}
+
+	if err = db.Watch(context.Background(), 0, nil); err == nil {
+		t.Fatal("expected an error for a zero interval")
+	}
+	memory, err := NewBINDatabase(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = memory.Watch(context.Background(), time.Second, nil); err == nil {
+		t.Fatal("expected an error for a database without a file")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		db.Watch(ctx, 10*time.Millisecond, func(err error) {
+			t.Error(err)
+		})
+		close(done)
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	// write a new file and rename it into place,
+	// so that Watch never reads a partly written file.
+	tmp := path + ".tmp"
+	if err = os.WriteFile(tmp, []byte("bin,country\n411111,DE\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	// make sure the modification time changes on coarse file systems.
+	if err = os.Chtimes(tmp, time.Now(), time.Now().Add(time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Rename(tmp, path); err != nil {
+		t.Fatal(err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for db.Len() != 1 {
+		if time.Now().After(deadline) {
+			t.Fatal("database was not reloaded")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if record, ok := db.Lookup("4111111111111111"); !ok || record.Country != "DE" {
+		t.Fatalf("unexpected record %+v\n", record)
+	}
+}
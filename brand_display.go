@@ -0,0 +1,197 @@
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package adyen
+
+import "strings"
+
+// brandNames holds the English display name of every brand in Brands.
+var brandNames = map[string]string{
+	"mc":                 "Mastercard",
+	"visadankort":        "Visa/Dankort",
+	"visa":               "Visa",
+	"amex":               "American Express",
+	"diners":             "Diners Club",
+	"maestrouk":          "Maestro UK",
+	"solo":               "Solo",
+	"laser":              "Laser",
+	"discover":           "Discover",
+	"jcb":                "JCB",
+	"bcmc":               "Bancontact",
+	"bijcard":            "de Bijenkorf Card",
+	"dankort":            "Dankort",
+	"hipercard":          "Hipercard",
+	"cup":                "UnionPay",
+	"maestro":            "Maestro",
+	"elo":                "Elo",
+	"uatp":               "UATP",
+	"cartebancaire":      "Carte Bancaire",
+	"visaalphabankbonus": "Alpha Bank Bonus Visa",
+	"mcalphabankbonus":   "Alpha Bank Bonus Mastercard",
+	"hiper":              "Hiper",
+	"oasis":              "Oasis",
+	"karenmillen":        "Karen Millen",
+	"warehouse":          "Warehouse",
+	"mir":                "Mir",
+	"codensa":            "Codensa",
+	"naranja":            "Naranja",
+	"cabal":              "Cabal",
+	"shopping":           "Tarjeta Shopping",
+	"argencard":          "Argencard",
+	"troy":               "Troy",
+	"forbrugsforeningen": "Forbrugsforeningen",
+	"vpay":               "V PAY",
+	"rupay":              "RuPay",
+}
+
+// localizedBrandNames holds the display names that differ from the English
+// name, by language and then by brand.
+var localizedBrandNames = map[string]map[string]string{
+	"el": {
+		"visaalphabankbonus": "Visa Alpha Bank Bonus",
+		"mcalphabankbonus":   "Mastercard Alpha Bank Bonus",
+	},
+	"es": {
+		"naranja":  "Tarjeta Naranja",
+		"shopping": "Tarjeta Shopping",
+	},
+	"fr": {
+		"cartebancaire": "Cartes Bancaires",
+	},
+	"ja": {
+		"mc":       "マスターカード",
+		"amex":     "アメリカン・エキスプレス",
+		"diners":   "ダイナースクラブ",
+		"discover": "ディスカバー",
+		"cup":      "銀聯",
+	},
+	"ko": {
+		"mc":   "마스터카드",
+		"visa": "비자",
+		"amex": "아메리칸 익스프레스",
+		"cup":  "유니온페이",
+	},
+	"nl": {
+		"bijcard": "de Bijenkorf Kaart",
+	},
+	"ru": {
+		"mc":  "Мастеркард",
+		"mir": "Мир",
+		"cup": "Юнионпэй",
+	},
+	"zh": {
+		"mc":     "万事达卡",
+		"amex":   "美国运通",
+		"diners": "大来卡",
+		"cup":    "银联",
+	},
+	"zh-hant": traditionalChineseBrandNames,
+	"zh-hk":   traditionalChineseBrandNames,
+	"zh-tw":   traditionalChineseBrandNames,
+}
+
+// traditionalChineseBrandNames holds the display names in Chinese
+// written with traditional characters.
+var traditionalChineseBrandNames = map[string]string{
+	"mc":     "萬事達卡",
+	"amex":   "美國運通",
+	"diners": "大來卡",
+	"cup":    "銀聯",
+}
+
+// BrandName returns the display name of a brand in the language of the given
+// BCP 47 language tag, like "en-US" or "ja".
+//
+// The English name is returned if the name is not localized for the language,
+// and the brand type itself is returned for unknown brands.
+func BrandName(brand, locale string) string {
+	for _, tag := range localeFallbacks(locale) {
+		if name, ok := localizedBrandNames[tag][brand]; ok {
+			return name
+		}
+	}
+	if name, ok := brandNames[brand]; ok {
+		return name
+	}
+	return brand
+}
+
+// localeFallbacks returns the lower-case BCP 47 tags to look up for locale,
+// from the most to the least specific. For example, "zh-Hant-TW" returns
+// "zh-hant-tw", "zh-hant" and "zh". Underscores are accepted as separators.
+func localeFallbacks(locale string) []string {
+	tag := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+
+	var tags []string
+	for tag != "" {
+		tags = append(tags, tag)
+
+		i := strings.LastIndexByte(tag, '-')
+		if i < 0 {
+			break
+		}
+		tag = tag[:i]
+	}
+	return tags
+}
+
+// An Environment is an Adyen environment, which decides the host
+// of URLs like the ones returned by BrandLogoURL.
+type Environment string
+
+const (
+	// EnvironmentTest is the test environment.
+	EnvironmentTest Environment = "test"
+
+	// EnvironmentLive is the live environment in Europe.
+	EnvironmentLive Environment = "live"
+
+	// EnvironmentLiveUS is the live environment in the United States.
+	EnvironmentLiveUS Environment = "live-us"
+
+	// EnvironmentLiveAU is the live environment in Australia.
+	EnvironmentLiveAU Environment = "live-au"
+
+	// EnvironmentLiveAPSE is the live environment in Asia Pacific South East.
+	EnvironmentLiveAPSE Environment = "live-apse"
+
+	// EnvironmentLiveIN is the live environment in India.
+	EnvironmentLiveIN Environment = "live-in"
+)
+
+// CheckoutshopperURL returns the base URL of Adyen's checkoutshopper
+// resources for env, like "https://checkoutshopper-test.adyen.com/checkoutshopper/".
+func (env Environment) CheckoutshopperURL() string {
+	return "https://checkoutshopper-" + string(env) + ".adyen.com/checkoutshopper/"
+}
+
+// BrandLogoURL returns the URL of a brand's SVG logo in the same location
+// as adyen-web loads it from, which is "images/logos/<brand>.svg".
+//
+// Example:
+//
+// EnvironmentTest, "visa" -> https://checkoutshopper-test.adyen.com/checkoutshopper/images/logos/visa.svg
+func BrandLogoURL(env Environment, brand string) string {
+	return env.CheckoutshopperURL() + "images/logos/" + brand + ".svg"
+}
@@ -0,0 +1,63 @@
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package adyen
+
+import "testing"
+
+func TestBrandName(t *testing.T) {
+	for _, brand := range Brands {
+		if _, ok := brandNames[brand.Type]; !ok {
+			t.Errorf("no display name for %s\n", brand.Type)
+		}
+	}
+
+	test := func(brand, locale, expected string) {
+		if name := BrandName(brand, locale); name != expected {
+			t.Fatalf("(%s, %s) should be %s, instead got %s\n", brand, locale, expected, name)
+		}
+	}
+
+	test("mc", "en-US", "Mastercard")
+	test("mc", "", "Mastercard")
+	test("cup", "zh-CN", "银联")
+	test("cup", "zh_TW", "銀聯")
+	test("cup", "zh-Hant-TW", "銀聯")
+	test("cup", "zh-Hans-CN", "银联")
+	test("mir", "ru", "Мир")
+	test("visa", "ja-JP", "Visa")
+	test("unknown", "en", "unknown")
+}
+
+func TestBrandLogoURL(t *testing.T) {
+	test := func(env Environment, brand, expected string) {
+		if url := BrandLogoURL(env, brand); url != expected {
+			t.Fatalf("should be %s, instead got %s\n", expected, url)
+		}
+	}
+
+	test(EnvironmentTest, "visa", "https://checkoutshopper-test.adyen.com/checkoutshopper/images/logos/visa.svg")
+	test(EnvironmentLive, "cartebancaire", "https://checkoutshopper-live.adyen.com/checkoutshopper/images/logos/cartebancaire.svg")
+	test(EnvironmentLiveUS, "mc", "https://checkoutshopper-live-us.adyen.com/checkoutshopper/images/logos/mc.svg")
+}
@@ -37,6 +37,13 @@ type BrowserInfo struct {
 
 	// UserAgent is the browser's user agent.
 	UserAgent string `json:"userAgent"`
+
+	// IP is the shopper's IP address, which is the "browserIP" of EMV 3DS.
+	// It is not part of Adyen's "browserInfo" object, which uses "shopperIP"
+	// next to it instead, so it is never marshaled.
+	//
+	// NewBrowserInfo leaves it empty, see RemoteIP.
+	IP string `json:"-"`
 }
 
 // Bool returns a pointer to value.
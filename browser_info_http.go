@@ -0,0 +1,260 @@
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package adyen
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// maxBrowserInfoBodySize is the maximum size of the JSON body read by NewBrowserInfo.
+const maxBrowserInfoBodySize = 64 << 10
+
+// clientBrowserInfo holds the BrowserInfo values that are only known by the
+// browser itself. Pointers are used so that missing values are not merged.
+type clientBrowserInfo struct {
+	ColorDepth        *int    `json:"colorDepth"`
+	JavaEnabled       *bool   `json:"javaEnabled"`
+	JavaScriptEnabled *bool   `json:"javaScriptEnabled"`
+	Language          *string `json:"language"`
+	ScreenHeight      *int    `json:"screenHeight"`
+	ScreenWidth       *int    `json:"screenWidth"`
+	TimeZoneOffset    *int    `json:"timeZoneOffset"`
+}
+
+// NewBrowserInfo returns the BrowserInfo of the shopper that sent r.
+//
+// AcceptHeader and UserAgent are taken from the request headers, and
+// Language is negotiated from the Accept-Language header with NegotiateLanguage
+// and the given supported languages.
+//
+// IP is left empty since the request cannot tell the shopper's address
+// apart from a proxy's. Set it from a trusted source, or with RemoteIP
+// if the server is not behind a proxy.
+//
+// If r has a body, it must be a JSON object with the values that only the
+// browser knows, like the one adyen-web collects, which is merged with MergeJSON.
+func NewBrowserInfo(r *http.Request, supported ...string) (*BrowserInfo, error) {
+	info := &BrowserInfo{
+		AcceptHeader: r.Header.Get("Accept"),
+		UserAgent:    r.UserAgent(),
+		Language:     NegotiateLanguage(r.Header.Get("Accept-Language"), supported...),
+	}
+
+	if r.Body == nil || r.Body == http.NoBody {
+		return info, nil
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r.Body, maxBrowserInfoBodySize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxBrowserInfoBodySize {
+		return nil, errors.New("adyen: browser info body is too large")
+	}
+	if len(strings.TrimSpace(string(data))) == 0 {
+		return info, nil
+	}
+	if err = info.MergeJSON(data); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// RemoteIP returns the IP address of the host that sent r, which is only the
+// shopper's IP address if the request did not pass through a proxy.
+//
+// Behind a load balancer or reverse proxy, this is the proxy's address.
+// Use the client address that the proxy reports instead, like the
+// X-Forwarded-For entry added by a trusted proxy, since sending the proxy's
+// address as the shopper's makes 3DS risk checks less accurate.
+func RemoteIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return ""
+	}
+	return host
+}
+
+// MergeJSON merges the client-reported values of the JSON object data
+// into b. These are ColorDepth, JavaEnabled, JavaScriptEnabled, ScreenHeight,
+// ScreenWidth and TimeZoneOffset, and Language if b has none and the
+// reported language is a well-formed language tag.
+//
+// Values missing from data are left unchanged, and all other values
+// are ignored since they are taken from the request headers.
+func (b *BrowserInfo) MergeJSON(data []byte) error {
+	var client clientBrowserInfo
+	if err := json.Unmarshal(data, &client); err != nil {
+		return err
+	}
+
+	if client.ColorDepth != nil {
+		b.ColorDepth = *client.ColorDepth
+	}
+	if client.JavaEnabled != nil {
+		b.JavaEnabled = *client.JavaEnabled
+	}
+	if client.JavaScriptEnabled != nil {
+		b.JavaScriptEnabled = Bool(*client.JavaScriptEnabled)
+	}
+	if client.Language != nil && b.Language == "" {
+		if tag, ok := canonicalLanguageTag(*client.Language); ok {
+			b.Language = tag
+		}
+	}
+	if client.ScreenHeight != nil {
+		b.ScreenHeight = *client.ScreenHeight
+	}
+	if client.ScreenWidth != nil {
+		b.ScreenWidth = *client.ScreenWidth
+	}
+	if client.TimeZoneOffset != nil {
+		b.TimeZoneOffset = *client.TimeZoneOffset
+	}
+	return nil
+}
+
+// NegotiateLanguage returns the best language tag of an Accept-Language
+// header value, with malformed tags and the "*" wildcard skipped.
+//
+// If supported languages are given, the returned tag is the supported
+// language that matches the most preferred language, where "en" matches
+// a preference for "en-US" and the other way around. If no supported
+// language matches, the first supported language is returned.
+//
+// An empty string is returned if there is no acceptable language.
+func NegotiateLanguage(acceptLanguage string, supported ...string) string {
+	type preference struct {
+		tag     string
+		quality float64
+	}
+
+	var prefs []preference
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(part, ";")
+
+		quality := 1.0
+		if k, v, ok := strings.Cut(strings.TrimSpace(params), "="); ok && strings.TrimSpace(k) == "q" {
+			q, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+			if err != nil {
+				continue
+			}
+			quality = q
+		}
+
+		tag, ok := canonicalLanguageTag(tag)
+		if ok && quality > 0 {
+			prefs = append(prefs, preference{tag, quality})
+		}
+	}
+	sort.SliceStable(prefs, func(i, j int) bool {
+		return prefs[i].quality > prefs[j].quality
+	})
+
+	if len(supported) == 0 {
+		if len(prefs) == 0 {
+			return ""
+		}
+		return prefs[0].tag
+	}
+
+	for _, pref := range prefs {
+		for _, lang := range supported {
+			if languageMatches(pref.tag, lang) {
+				return lang
+			}
+		}
+	}
+	return supported[0]
+}
+
+// languageMatches reports whether a and b are the same language tag,
+// or one is a prefix of the other, like "en" and "en-US".
+func languageMatches(a, b string) bool {
+	a, b = strings.ToLower(a), strings.ToLower(b)
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	return a == b || strings.HasPrefix(b, a+"-")
+}
+
+// canonicalLanguageTag checks that tag is a well-formed BCP 47 language tag
+// and returns it with the usual casing, like "zh-Hant-TW".
+// Underscores are accepted as separators.
+func canonicalLanguageTag(tag string) (string, bool) {
+	tag = strings.ReplaceAll(strings.TrimSpace(tag), "_", "-")
+	if tag == "" || tag == "*" {
+		return "", false
+	}
+
+	subtags := strings.Split(tag, "-")
+	for i, subtag := range subtags {
+		if len(subtag) == 0 || len(subtag) > 8 || !isAlphanumeric(subtag) {
+			return "", false
+		}
+
+		switch {
+		case i == 0:
+			if len(subtag) < 2 || !isLetters(subtag) {
+				return "", false
+			}
+			subtags[i] = strings.ToLower(subtag)
+		case len(subtag) == 2 && isLetters(subtag):
+			subtags[i] = strings.ToUpper(subtag)
+		case len(subtag) == 4 && isLetters(subtag):
+			subtags[i] = strings.ToUpper(subtag[:1]) + strings.ToLower(subtag[1:])
+		default:
+			subtags[i] = strings.ToLower(subtag)
+		}
+	}
+	return strings.Join(subtags, "-"), true
+}
+
+// isLetters reports whether s consists of ASCII letters only.
+func isLetters(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if c := s[i] | 0x20; c < 'a' || c > 'z' {
+			return false
+		}
+	}
+	return true
+}
+
+// isAlphanumeric reports whether s consists of ASCII letters and digits only.
+func isAlphanumeric(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; (c < '0' || c > '9') && !isLetters(s[i:i+1]) {
+			return false
+		}
+	}
+	return true
+}
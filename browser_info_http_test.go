@@ -0,0 +1,135 @@
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package adyen
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestNegotiateLanguage(t *testing.T) {
+	test := func(acceptLanguage string, supported []string, expected string) {
+		if tag := NegotiateLanguage(acceptLanguage, supported...); tag != expected {
+			t.Fatalf("(%q, %v) should be %q, instead got %q\n", acceptLanguage, supported, expected, tag)
+		}
+	}
+
+	test("en-US,en;q=0.9", nil, "en-US")
+	test("de;q=0.5, fr-ch , en;q=0.8", nil, "fr-CH")
+	test("zh-hant-tw", nil, "zh-Hant-TW")
+	test("*;q=1, nl;q=0.1", nil, "nl")
+	test("en;q=0, nl;q=0.1", nil, "nl")
+	test("en;q=abc, nl;q=0.1", nil, "nl")
+	test("en_GB", nil, "en-GB")
+	test("e, 123, toolonglanguage", nil, "")
+	test("", nil, "")
+
+	supported := []string{"en-US", "nl-NL", "de"}
+	test("nl,en;q=0.5", supported, "nl-NL")
+	test("de-AT,en;q=0.5", supported, "de")
+	test("fr-FR,en;q=0.5", supported, "en-US")
+	test("fr-FR", supported, "en-US")
+	test("", supported, "en-US")
+}
+
+func TestNewBrowserInfo(t *testing.T) {
+	body := `{"acceptHeader":"ignored","colorDepth":24,"javaEnabled":false,"language":"fr-FR",` +
+		`"screenHeight":1080,"screenWidth":1920,"timeZoneOffset":-60,"userAgent":"ignored"}`
+
+	r := httptest.NewRequest("POST", "/", strings.NewReader(body))
+	r.Header.Set("Accept", "text/html")
+	r.Header.Set("Accept-Language", "nl-NL,nl;q=0.9,en;q=0.8")
+	r.Header.Set("User-Agent", "Mozilla/5.0")
+
+	info, err := NewBrowserInfo(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := BrowserInfo{
+		AcceptHeader:   "text/html",
+		ColorDepth:     24,
+		Language:       "nl-NL",
+		ScreenHeight:   1080,
+		ScreenWidth:    1920,
+		TimeZoneOffset: -60,
+		UserAgent:      "Mozilla/5.0",
+	}
+	if *info != expected {
+		t.Fatalf("expected %+v, instead got %+v\n", expected, *info)
+	}
+
+	// without Accept-Language, the client-reported language is used.
+	r = httptest.NewRequest("POST", "/", strings.NewReader(body))
+	if info, err = NewBrowserInfo(r); err != nil {
+		t.Fatal(err)
+	} else if info.Language != "fr-FR" {
+		t.Fatalf("unexpected language %q\n", info.Language)
+	}
+
+	// a request without body only has the header values.
+	r = httptest.NewRequest("GET", "/", nil)
+	r.Header.Set("Accept-Language", "de")
+	if info, err = NewBrowserInfo(r, "en", "de-DE"); err != nil {
+		t.Fatal(err)
+	} else if *info != (BrowserInfo{Language: "de-DE"}) {
+		t.Fatalf("unexpected browser info %+v\n", *info)
+	}
+
+	r = httptest.NewRequest("POST", "/", strings.NewReader("not json"))
+	if _, err = NewBrowserInfo(r); err == nil {
+		t.Fatal("expected an error for a malformed body")
+	}
+}
+
+func TestBrowserInfo_MergeJSON(t *testing.T) {
+	info := BrowserInfo{ColorDepth: 24, ScreenHeight: 1080, Language: "en"}
+	if err := info.MergeJSON([]byte(`{"screenHeight":900,"javaScriptEnabled":true,"language":"de"}`)); err != nil {
+		t.Fatal(err)
+	}
+
+	if info.ColorDepth != 24 || info.ScreenHeight != 900 || info.Language != "en" ||
+		info.JavaScriptEnabled == nil || !*info.JavaScriptEnabled {
+		t.Fatalf("unexpected browser info %+v\n", info)
+	}
+}
+
+func TestRemoteIP(t *testing.T) {
+	r := httptest.NewRequest("GET", "/", nil)
+	if ip := RemoteIP(r); ip != "192.0.2.1" {
+		t.Fatalf("unexpected IP %q\n", ip)
+	}
+
+	r.RemoteAddr = "[2001:db8::1]:443"
+	if ip := RemoteIP(r); ip != "2001:db8::1" {
+		t.Fatalf("unexpected IP %q\n", ip)
+	}
+
+	r.RemoteAddr = "invalid"
+	if ip := RemoteIP(r); ip != "" {
+		t.Fatalf("unexpected IP %q\n", ip)
+	}
+}
@@ -0,0 +1,191 @@
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package adyen
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// ColorDepths are the color depths that EMVCo 3DS2 allows in browser data.
+var ColorDepths = []int{1, 4, 8, 15, 16, 24, 32, 48}
+
+const (
+	// MaxBrowserHeaderLength is the maximum length of AcceptHeader and UserAgent.
+	MaxBrowserHeaderLength = 2048
+
+	// MaxBrowserLanguageLength is the maximum length of Language.
+	MaxBrowserLanguageLength = 8
+
+	// MaxScreenSize is the maximum ScreenHeight and ScreenWidth,
+	// which are at most 6 digits long.
+	MaxScreenSize = 999999
+
+	// MinTimeZoneOffset and MaxTimeZoneOffset are the bounds of TimeZoneOffset,
+	// from UTC+14:00 to UTC-12:00.
+	MinTimeZoneOffset = -14 * 60
+	MaxTimeZoneOffset = 12 * 60
+)
+
+// Validate validates b with the EMVCo 3DS2 rules for browser data.
+// Use Fix first to change values that can be fixed into valid ones.
+//
+// If err != nil, it is ValidationErrors, with the JSON names of
+// the invalid fields, like "colorDepth".
+func (b *BrowserInfo) Validate() error {
+	var errs ValidationErrors
+
+	check := func(field string, incomplete, invalid bool) {
+		switch {
+		case incomplete:
+			errs = append(errs, &ValidationError{Field: field, Code: ErrFieldIncomplete})
+		case invalid:
+			errs = append(errs, &ValidationError{Field: field, Code: ErrFieldInvalid})
+		}
+	}
+
+	check("acceptHeader", b.AcceptHeader == "", len(b.AcceptHeader) > MaxBrowserHeaderLength)
+	check("colorDepth", b.ColorDepth == 0, !contains(ColorDepths, b.ColorDepth))
+	check("language", b.Language == "", len(b.Language) > MaxBrowserLanguageLength)
+	check("screenHeight", b.ScreenHeight == 0, b.ScreenHeight < 0 || b.ScreenHeight > MaxScreenSize)
+	check("screenWidth", b.ScreenWidth == 0, b.ScreenWidth < 0 || b.ScreenWidth > MaxScreenSize)
+	check("timeZoneOffset", false, b.TimeZoneOffset < MinTimeZoneOffset || b.TimeZoneOffset > MaxTimeZoneOffset)
+	check("userAgent", b.UserAgent == "", len(b.UserAgent) > MaxBrowserHeaderLength)
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// Fix changes the values of b that Validate would reject into the nearest
+// valid values, and returns the JSON names of the fields it changed.
+//
+//   - ColorDepth is rounded to the nearest value of ColorDepths, preferring the
+//     lower one on ties.
+//   - Language uses "-" instead of "_" to separate subtags, and loses its last
+//     subtags until it is short enough, so "en-US-x-custom" becomes "en-US".
+//   - ScreenHeight and ScreenWidth are clamped to between 1 and MaxScreenSize,
+//     and TimeZoneOffset to its bounds.
+//   - AcceptHeader and UserAgent are truncated.
+//
+// Missing values, like a ColorDepth of 0, cannot be fixed and are left
+// as they are, so that Validate still reports them.
+func (b *BrowserInfo) Fix() (fixed []string) {
+	fix := func(field string, changed bool) {
+		if changed {
+			fixed = append(fixed, field)
+		}
+	}
+
+	fix("acceptHeader", truncateString(&b.AcceptHeader, MaxBrowserHeaderLength))
+	fix("colorDepth", fixColorDepth(&b.ColorDepth))
+	fix("language", fixLanguage(&b.Language))
+	fix("screenHeight", fixScreenSize(&b.ScreenHeight))
+	fix("screenWidth", fixScreenSize(&b.ScreenWidth))
+	fix("timeZoneOffset", clamp(&b.TimeZoneOffset, MinTimeZoneOffset, MaxTimeZoneOffset))
+	fix("userAgent", truncateString(&b.UserAgent, MaxBrowserHeaderLength))
+	return
+}
+
+// fixColorDepth rounds *depth to the nearest value of ColorDepths,
+// unless it is missing.
+func fixColorDepth(depth *int) bool {
+	if *depth == 0 || contains(ColorDepths, *depth) {
+		return false
+	}
+
+	nearest := ColorDepths[0]
+	for _, d := range ColorDepths[1:] {
+		if abs(d-*depth) < abs(nearest-*depth) {
+			nearest = d
+		}
+	}
+	*depth = nearest
+	return true
+}
+
+// fixLanguage replaces "_" with "-" in *lang and removes its last subtags
+// until it is at most MaxBrowserLanguageLength long.
+func fixLanguage(lang *string) bool {
+	fixed := strings.Contains(*lang, "_")
+	if fixed {
+		*lang = strings.ReplaceAll(*lang, "_", "-")
+	}
+	if len(*lang) <= MaxBrowserLanguageLength {
+		return fixed
+	}
+	for _, tag := range localeFallbacks(*lang) {
+		// a singleton like "x" cannot end a tag.
+		if len(tag) <= MaxBrowserLanguageLength && len(tag) > 1 && tag[len(tag)-2] != '-' {
+			// keep the original casing.
+			*lang = (*lang)[:len(tag)]
+			return true
+		}
+	}
+	*lang = (*lang)[:MaxBrowserLanguageLength]
+	return true
+}
+
+// fixScreenSize clamps *size to the range [1, MaxScreenSize], unless it is missing.
+func fixScreenSize(size *int) bool {
+	if *size == 0 {
+		return false
+	}
+	return clamp(size, 1, MaxScreenSize)
+}
+
+// clamp limits *v to the range [min, max].
+func clamp(v *int, min, max int) bool {
+	switch {
+	case *v < min:
+		*v = min
+	case *v > max:
+		*v = max
+	default:
+		return false
+	}
+	return true
+}
+
+// truncateString truncates *s to at most n bytes without splitting runes.
+func truncateString(s *string, n int) bool {
+	if len(*s) <= n {
+		return false
+	}
+	for n > 0 && !utf8.RuneStart((*s)[n]) {
+		n--
+	}
+	*s = (*s)[:n]
+	return true
+}
+
+// abs returns the absolute value of v.
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,133 @@
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package adyen
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// validBrowserInfo returns a BrowserInfo that passes Validate.
+func validBrowserInfo() BrowserInfo {
+	return BrowserInfo{
+		AcceptHeader:   "text/html",
+		ColorDepth:     24,
+		Language:       "en-US",
+		ScreenHeight:   1080,
+		ScreenWidth:    1920,
+		TimeZoneOffset: -60,
+		UserAgent:      "Mozilla/5.0",
+	}
+}
+
+func TestBrowserInfo_Validate(t *testing.T) {
+	test := func(modify func(b *BrowserInfo), expected map[string]ErrorCode) {
+		info := validBrowserInfo()
+		modify(&info)
+
+		codes := make(map[string]ErrorCode)
+		if err := info.Validate(); err != nil {
+			var errs ValidationErrors
+			if !errors.As(err, &errs) {
+				t.Fatalf("unexpected error %v\n", err)
+			}
+			for _, err := range errs {
+				codes[err.Field] = err.Code
+			}
+		}
+		if len(expected) == 0 && len(codes) == 0 {
+			return
+		}
+		if !reflect.DeepEqual(codes, expected) {
+			t.Fatalf("%+v should have errors %v, instead got %v\n", info, expected, codes)
+		}
+	}
+
+	test(func(b *BrowserInfo) {}, nil)
+	test(func(b *BrowserInfo) { b.ColorDepth = 48 }, nil)
+	test(func(b *BrowserInfo) { b.TimeZoneOffset = 0 }, nil)
+	test(func(b *BrowserInfo) { b.TimeZoneOffset = -840 }, nil)
+	test(func(b *BrowserInfo) { b.Language = "zh-Hant" }, nil)
+
+	test(func(b *BrowserInfo) { b.ColorDepth = 30 }, map[string]ErrorCode{"colorDepth": ErrFieldInvalid})
+	test(func(b *BrowserInfo) { b.ColorDepth = 0 }, map[string]ErrorCode{"colorDepth": ErrFieldIncomplete})
+	test(func(b *BrowserInfo) { b.Language = "zh-Hant-TW" }, map[string]ErrorCode{"language": ErrFieldInvalid})
+	test(func(b *BrowserInfo) { b.TimeZoneOffset = 721 }, map[string]ErrorCode{"timeZoneOffset": ErrFieldInvalid})
+	test(func(b *BrowserInfo) { b.ScreenWidth = 1000000 }, map[string]ErrorCode{"screenWidth": ErrFieldInvalid})
+	test(func(b *BrowserInfo) { b.ScreenHeight = -1 }, map[string]ErrorCode{"screenHeight": ErrFieldInvalid})
+	test(func(b *BrowserInfo) { b.UserAgent = strings.Repeat("a", 2049) }, map[string]ErrorCode{"userAgent": ErrFieldInvalid})
+	test(func(b *BrowserInfo) { *b = BrowserInfo{} }, map[string]ErrorCode{
+		"acceptHeader": ErrFieldIncomplete,
+		"colorDepth":   ErrFieldIncomplete,
+		"language":     ErrFieldIncomplete,
+		"screenHeight": ErrFieldIncomplete,
+		"screenWidth":  ErrFieldIncomplete,
+		"userAgent":    ErrFieldIncomplete,
+	})
+}
+
+func TestBrowserInfo_Fix(t *testing.T) {
+	test := func(modify func(b *BrowserInfo), check func(b *BrowserInfo) bool, expected ...string) {
+		info := validBrowserInfo()
+		modify(&info)
+
+		if fixed := info.Fix(); !reflect.DeepEqual(fixed, expected) {
+			t.Fatalf("expected fixed fields %v, instead got %v\n", expected, fixed)
+		}
+		if !check(&info) {
+			t.Fatalf("unexpected fixed browser info %+v\n", info)
+		}
+		if err := info.Validate(); err != nil {
+			t.Fatalf("fixed browser info is not valid: %v\n", err)
+		}
+	}
+
+	test(func(b *BrowserInfo) {}, func(b *BrowserInfo) bool { return *b == validBrowserInfo() })
+	test(func(b *BrowserInfo) { b.ColorDepth = 30 }, func(b *BrowserInfo) bool { return b.ColorDepth == 32 }, "colorDepth")
+	test(func(b *BrowserInfo) { b.ColorDepth = 20 }, func(b *BrowserInfo) bool { return b.ColorDepth == 16 }, "colorDepth")
+	test(func(b *BrowserInfo) { b.ColorDepth = 64 }, func(b *BrowserInfo) bool { return b.ColorDepth == 48 }, "colorDepth")
+	test(func(b *BrowserInfo) { b.ColorDepth = -1 }, func(b *BrowserInfo) bool { return b.ColorDepth == 1 }, "colorDepth")
+	test(func(b *BrowserInfo) { b.Language = "en-US-x-custom" }, func(b *BrowserInfo) bool { return b.Language == "en-US" }, "language")
+	test(func(b *BrowserInfo) { b.Language = "a-verylongsubtag" }, func(b *BrowserInfo) bool { return b.Language == "a-verylo" }, "language")
+	test(func(b *BrowserInfo) { b.Language = "zh_Hant_TW" }, func(b *BrowserInfo) bool { return b.Language == "zh-Hant" }, "language")
+	test(func(b *BrowserInfo) { b.Language = "zh_TW" }, func(b *BrowserInfo) bool { return b.Language == "zh-TW" }, "language")
+	test(func(b *BrowserInfo) { b.TimeZoneOffset = 900 }, func(b *BrowserInfo) bool { return b.TimeZoneOffset == 720 }, "timeZoneOffset")
+	test(func(b *BrowserInfo) { b.ScreenWidth = 1234567 }, func(b *BrowserInfo) bool { return b.ScreenWidth == 999999 }, "screenWidth")
+	test(func(b *BrowserInfo) { b.ScreenHeight = -768 }, func(b *BrowserInfo) bool { return b.ScreenHeight == 1 }, "screenHeight")
+	test(func(b *BrowserInfo) { b.UserAgent = strings.Repeat("é", 1025) },
+		func(b *BrowserInfo) bool { return b.UserAgent == strings.Repeat("é", 1024) }, "userAgent")
+
+	// missing values are left for Validate to report.
+	info := validBrowserInfo()
+	info.ColorDepth, info.Language, info.ScreenHeight = 0, "", 0
+	if fixed := info.Fix(); len(fixed) != 0 || info.ColorDepth != 0 || info.Language != "" || info.ScreenHeight != 0 {
+		t.Fatalf("expected no fixed fields, instead got %v for %+v\n", fixed, info)
+	}
+	if err := info.Validate(); err == nil {
+		t.Fatal("expected an error for missing values")
+	}
+}
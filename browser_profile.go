@@ -0,0 +1,297 @@
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package adyen
+
+import "math/rand"
+
+// A ChallengeWindowSize is an EMV 3DS "challengeWindowSize" value,
+// which is the size of the window that shows a 3DS challenge.
+type ChallengeWindowSize string
+
+const (
+	// ChallengeWindow250x400 is a window of 250 by 400 pixels.
+	ChallengeWindow250x400 ChallengeWindowSize = "01"
+
+	// ChallengeWindow390x400 is a window of 390 by 400 pixels.
+	ChallengeWindow390x400 ChallengeWindowSize = "02"
+
+	// ChallengeWindow500x600 is a window of 500 by 600 pixels.
+	ChallengeWindow500x600 ChallengeWindowSize = "03"
+
+	// ChallengeWindow600x400 is a window of 600 by 400 pixels.
+	ChallengeWindow600x400 ChallengeWindowSize = "04"
+
+	// ChallengeWindowFullScreen is a window that fills the screen.
+	ChallengeWindowFullScreen ChallengeWindowSize = "05"
+)
+
+// A ScreenSize is the size of a screen in CSS pixels.
+type ScreenSize struct {
+	Width, Height int
+}
+
+// ChallengeWindowSize returns the suggested challenge window size for s.
+// Screens narrower than 600 pixels, like phones, use the full screen.
+// Other screens use the largest fixed window that fits.
+func (s ScreenSize) ChallengeWindowSize() ChallengeWindowSize {
+	switch {
+	case s.Width < 600:
+		return ChallengeWindowFullScreen
+	case s.Height >= 600:
+		return ChallengeWindow500x600
+	default:
+		return ChallengeWindow600x400
+	}
+}
+
+// A BrowserPlatform is an operating system a browser runs on, with the
+// user agents of the browser on it and common screen sizes of its devices.
+type BrowserPlatform struct {
+	// Name is the name of the platform, like "windows".
+	Name string
+
+	// UserAgents are user agents of different versions of the browser.
+	UserAgents []string
+
+	// ScreenSizes are common screen sizes of the devices.
+	ScreenSizes []ScreenSize
+}
+
+// A BrowserProfile holds realistic BrowserInfo values of one kind of browser,
+// for use in tests. User agents and screen sizes are grouped by platform,
+// so BrowserInfo and BrowserInfoGenerator.Generate always return values
+// that the browser could report together.
+type BrowserProfile struct {
+	// Name is the name of the profile, like "desktop-chrome".
+	Name string
+
+	// AcceptHeader is the Accept header the browser sends when navigating.
+	AcceptHeader string
+
+	// Platforms are the platforms the browser runs on.
+	Platforms []BrowserPlatform
+
+	// ColorDepths are the color depths the browser reports.
+	ColorDepths []int
+}
+
+// browserLocale is a language together with the time zone offsets of
+// the countries where it is most likely used.
+type browserLocale struct {
+	language        string
+	timeZoneOffsets []int
+}
+
+// browserLocales are the locales used by BrowserInfoGenerator.
+// The first one is used by BrowserProfile.BrowserInfo.
+var browserLocales = []browserLocale{
+	{"en-US", []int{300, 240, 360, 420, 480}},
+	{"en-GB", []int{0, -60}},
+	{"nl-NL", []int{-60, -120}},
+	{"de-DE", []int{-60, -120}},
+	{"fr-FR", []int{-60, -120}},
+	{"es-ES", []int{-60, -120}},
+	{"pt-BR", []int{180}},
+	{"ja-JP", []int{-540}},
+	{"en-AU", []int{-600, -660}},
+}
+
+const (
+	chromeAcceptHeader  = "text/html,application/xhtml+xml,application/xml;q=0.9,image/avif,image/webp,image/apng,*/*;q=0.8,application/signed-exchange;v=b3;q=0.7"
+	safariAcceptHeader  = "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8"
+	firefoxAcceptHeader = "text/html,application/xhtml+xml,application/xml;q=0.9,image/avif,image/webp,*/*;q=0.8"
+)
+
+var (
+	// windowsScreenSizes, macScreenSizes and linuxScreenSizes are common
+	// desktop screen sizes of each platform.
+	windowsScreenSizes = []ScreenSize{{1920, 1080}, {1366, 768}, {1536, 864}, {2560, 1440}, {1280, 720}}
+	macScreenSizes     = []ScreenSize{{1440, 900}, {1512, 982}, {1728, 1117}, {1680, 1050}, {2560, 1440}}
+	linuxScreenSizes   = []ScreenSize{{1920, 1080}, {2560, 1440}, {1280, 1024}}
+)
+
+var (
+	// DesktopChrome is Chrome on Windows and macOS.
+	DesktopChrome = &BrowserProfile{
+		Name:         "desktop-chrome",
+		AcceptHeader: chromeAcceptHeader,
+		Platforms: []BrowserPlatform{
+			{
+				Name: "windows",
+				UserAgents: []string{
+					"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36",
+					"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/123.0.0.0 Safari/537.36",
+				},
+				ScreenSizes: windowsScreenSizes,
+			},
+			{
+				Name: "macos",
+				UserAgents: []string{
+					"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36",
+					"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/122.0.0.0 Safari/537.36",
+				},
+				ScreenSizes: macScreenSizes,
+			},
+		},
+		ColorDepths: []int{24},
+	}
+
+	// DesktopSafari is Safari on macOS.
+	DesktopSafari = &BrowserProfile{
+		Name:         "desktop-safari",
+		AcceptHeader: safariAcceptHeader,
+		Platforms: []BrowserPlatform{
+			{
+				Name: "macos",
+				UserAgents: []string{
+					"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.4.1 Safari/605.1.15",
+					"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.3 Safari/605.1.15",
+					"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/16.6 Safari/605.1.15",
+				},
+				ScreenSizes: macScreenSizes,
+			},
+		},
+		ColorDepths: []int{24},
+	}
+
+	// DesktopFirefox is Firefox on Windows, macOS and Linux.
+	DesktopFirefox = &BrowserProfile{
+		Name:         "desktop-firefox",
+		AcceptHeader: firefoxAcceptHeader,
+		Platforms: []BrowserPlatform{
+			{
+				Name: "windows",
+				UserAgents: []string{
+					"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:125.0) Gecko/20100101 Firefox/125.0",
+					"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:124.0) Gecko/20100101 Firefox/124.0",
+				},
+				ScreenSizes: windowsScreenSizes,
+			},
+			{
+				Name: "macos",
+				UserAgents: []string{
+					"Mozilla/5.0 (Macintosh; Intel Mac OS X 10.15; rv:125.0) Gecko/20100101 Firefox/125.0",
+				},
+				ScreenSizes: macScreenSizes,
+			},
+			{
+				Name: "linux",
+				UserAgents: []string{
+					"Mozilla/5.0 (X11; Linux x86_64; rv:125.0) Gecko/20100101 Firefox/125.0",
+				},
+				ScreenSizes: linuxScreenSizes,
+			},
+		},
+		ColorDepths: []int{24},
+	}
+
+	// MobileSafari is Safari on iPhone.
+	MobileSafari = &BrowserProfile{
+		Name:         "mobile-safari",
+		AcceptHeader: safariAcceptHeader,
+		Platforms: []BrowserPlatform{
+			{
+				Name: "ios",
+				UserAgents: []string{
+					"Mozilla/5.0 (iPhone; CPU iPhone OS 17_4_1 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.4.1 Mobile/15E148 Safari/604.1",
+					"Mozilla/5.0 (iPhone; CPU iPhone OS 17_3 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.3 Mobile/15E148 Safari/604.1",
+					"Mozilla/5.0 (iPhone; CPU iPhone OS 16_6 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/16.6 Mobile/15E148 Safari/604.1",
+				},
+				ScreenSizes: []ScreenSize{{390, 844}, {393, 852}, {375, 667}, {414, 896}, {428, 926}, {430, 932}},
+			},
+		},
+		ColorDepths: []int{32},
+	}
+
+	// MobileChrome is Chrome on Android.
+	MobileChrome = &BrowserProfile{
+		Name:         "mobile-chrome",
+		AcceptHeader: chromeAcceptHeader,
+		Platforms: []BrowserPlatform{
+			{
+				Name: "android",
+				UserAgents: []string{
+					"Mozilla/5.0 (Linux; Android 10; K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Mobile Safari/537.36",
+					"Mozilla/5.0 (Linux; Android 10; K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/123.0.0.0 Mobile Safari/537.36",
+				},
+				ScreenSizes: []ScreenSize{{412, 915}, {360, 800}, {384, 854}, {393, 873}, {360, 780}},
+			},
+		},
+		ColorDepths: []int{24},
+	}
+
+	// BrowserProfiles are all built-in browser profiles.
+	BrowserProfiles = []*BrowserProfile{DesktopChrome, DesktopSafari, DesktopFirefox, MobileSafari, MobileChrome}
+)
+
+// BrowserInfo returns the BrowserInfo of the first values of p,
+// with the "en-US" language and the time zone of New York in winter.
+func (p *BrowserProfile) BrowserInfo() BrowserInfo {
+	platform := &p.Platforms[0]
+	return p.browserInfo(platform.UserAgents[0], platform.ScreenSizes[0], p.ColorDepths[0], browserLocales[0].language, browserLocales[0].timeZoneOffsets[0])
+}
+
+// browserInfo returns the BrowserInfo with the given values of p.
+func (p *BrowserProfile) browserInfo(userAgent string, screen ScreenSize, colorDepth int, language string, timeZoneOffset int) BrowserInfo {
+	return BrowserInfo{
+		AcceptHeader:      p.AcceptHeader,
+		ColorDepth:        colorDepth,
+		JavaScriptEnabled: Bool(true),
+		Language:          language,
+		ScreenHeight:      screen.Height,
+		ScreenWidth:       screen.Width,
+		TimeZoneOffset:    timeZoneOffset,
+		UserAgent:         userAgent,
+	}
+}
+
+// A BrowserInfoGenerator generates random BrowserInfo values from
+// a BrowserProfile for testing.
+type BrowserInfoGenerator struct {
+	rand *rand.Rand
+}
+
+// NewBrowserInfoGenerator creates a new BrowserInfoGenerator that uses src as its random source.
+// Generators with sources seeded the same way generate the same browser data.
+func NewBrowserInfoGenerator(src rand.Source) *BrowserInfoGenerator {
+	return &BrowserInfoGenerator{rand: rand.New(src)}
+}
+
+// Generate generates BrowserInfo with a random platform of p, and a random
+// user agent and screen size of that platform. The language is random, and
+// the time zone offset is one of the countries where the language is used.
+//
+// The screen size decides the challenge window size, see ScreenSize.ChallengeWindowSize.
+func (g *BrowserInfoGenerator) Generate(p *BrowserProfile) BrowserInfo {
+	platform := &p.Platforms[g.rand.Intn(len(p.Platforms))]
+	locale := &browserLocales[g.rand.Intn(len(browserLocales))]
+	return p.browserInfo(
+		platform.UserAgents[g.rand.Intn(len(platform.UserAgents))],
+		platform.ScreenSizes[g.rand.Intn(len(platform.ScreenSizes))],
+		p.ColorDepths[g.rand.Intn(len(p.ColorDepths))],
+		locale.language,
+		locale.timeZoneOffsets[g.rand.Intn(len(locale.timeZoneOffsets))],
+	)
+}
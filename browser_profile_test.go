@@ -0,0 +1,121 @@
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package adyen
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestBrowserProfile_BrowserInfo(t *testing.T) {
+	for _, p := range BrowserProfiles {
+		info := p.BrowserInfo()
+		if err := info.Validate(); err != nil {
+			t.Fatalf("%s: %v\n", p.Name, err)
+		}
+		if info.UserAgent != p.Platforms[0].UserAgents[0] || info.AcceptHeader != p.AcceptHeader || info.Language != "en-US" {
+			t.Fatalf("%s: unexpected browser info %+v\n", p.Name, info)
+		}
+	}
+}
+
+func TestBrowserInfoGenerator_Generate(t *testing.T) {
+	g := NewBrowserInfoGenerator(rand.NewSource(1))
+
+	for _, p := range BrowserProfiles {
+		platforms := make(map[string]bool)
+		for i := 0; i < 200; i++ {
+			info := g.Generate(p)
+			if err := info.Validate(); err != nil {
+				t.Fatalf("%s: %v\n", p.Name, err)
+			}
+
+			if info.AcceptHeader != p.AcceptHeader || !contains(p.ColorDepths, info.ColorDepth) {
+				t.Fatalf("%s: browser info %+v does not match the profile\n", p.Name, info)
+			}
+
+			// the user agent and screen size must be of the same platform.
+			var platform string
+			for _, pl := range p.Platforms {
+				if contains(pl.UserAgents, info.UserAgent) &&
+					contains(pl.ScreenSizes, ScreenSize{info.ScreenWidth, info.ScreenHeight}) {
+					platform = pl.Name
+				}
+			}
+			if platform == "" {
+				t.Fatalf("%s: user agent and screen size of %+v are not of the same platform\n", p.Name, info)
+			}
+			platforms[platform] = true
+
+			var found bool
+			for _, locale := range browserLocales {
+				if locale.language == info.Language && contains(locale.timeZoneOffsets, info.TimeZoneOffset) {
+					found = true
+				}
+			}
+			if !found {
+				t.Fatalf("%s: time zone offset %d does not match %s\n", p.Name, info.TimeZoneOffset, info.Language)
+			}
+		}
+		if len(platforms) != len(p.Platforms) {
+			t.Fatalf("%s: expected all platforms, instead got %v\n", p.Name, platforms)
+		}
+	}
+
+	// generators with the same seed generate the same browser data.
+	a, b := NewBrowserInfoGenerator(rand.NewSource(42)), NewBrowserInfoGenerator(rand.NewSource(42))
+	for i := 0; i < 10; i++ {
+		x, y := a.Generate(DesktopFirefox), b.Generate(DesktopFirefox)
+		x.JavaScriptEnabled, y.JavaScriptEnabled = nil, nil
+		if x != y {
+			t.Fatalf("expected %+v, instead got %+v\n", x, y)
+		}
+	}
+}
+
+func TestScreenSize_ChallengeWindowSize(t *testing.T) {
+	test := func(s ScreenSize, expected ChallengeWindowSize) {
+		if size := s.ChallengeWindowSize(); size != expected {
+			t.Fatalf("%+v should be %s, instead got %s\n", s, expected, size)
+		}
+	}
+
+	test(ScreenSize{390, 844}, ChallengeWindowFullScreen)
+	test(ScreenSize{1920, 1080}, ChallengeWindow500x600)
+	test(ScreenSize{1024, 500}, ChallengeWindow600x400)
+
+	// the presets cover both mobile and desktop layouts.
+	sizes := make(map[ChallengeWindowSize]bool)
+	for _, p := range BrowserProfiles {
+		for _, platform := range p.Platforms {
+			for _, s := range platform.ScreenSizes {
+				sizes[s.ChallengeWindowSize()] = true
+			}
+		}
+	}
+	if !sizes[ChallengeWindowFullScreen] || !sizes[ChallengeWindow500x600] {
+		t.Fatalf("unexpected challenge window sizes %v\n", sizes)
+	}
+}
@@ -0,0 +1,213 @@
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package adyen
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// A Card holds the details of a payment card.
+//
+// The String, GoString and MarshalJSON methods never reveal the card number
+// or security code, so a Card can be logged safely.
+type Card struct {
+	// Number is the card number.
+	Number string `json:"number"`
+
+	// SecurityCode is the security code (CVV/CVC).
+	SecurityCode string `json:"cvc,omitempty"`
+
+	// ExpiryMonth and ExpiryYear are the expiry month and fully-qualified year.
+	ExpiryMonth time.Month `json:"expiryMonth"`
+	ExpiryYear  int        `json:"expiryYear"`
+
+	// HolderName is the optional cardholder name.
+	HolderName string `json:"holderName,omitempty"`
+}
+
+// An EncryptedCard holds the fields of a Card that were encrypted one by one,
+// as adyen-web does with its secured fields.
+type EncryptedCard struct {
+	EncryptedCardNumber  string `json:"encryptedCardNumber"`
+	EncryptedExpiryMonth string `json:"encryptedExpiryMonth"`
+	EncryptedExpiryYear  string `json:"encryptedExpiryYear"`
+
+	// EncryptedSecurityCode is empty if the security code was left out.
+	EncryptedSecurityCode string `json:"encryptedSecurityCode,omitempty"`
+}
+
+// Normalize normalizes the card number with NormalizeCardNumber and the
+// holder name, if any, with NormalizeHolderName and the given options.
+//
+// If err != nil, c is not changed.
+func (c *Card) Normalize(opts HolderNameOptions) (changes HolderNameChanges, err error) {
+	number, err := NormalizeCardNumber(c.Number)
+	if err != nil {
+		return
+	}
+
+	holderName := c.HolderName
+	if holderName != "" {
+		if holderName, changes, err = NormalizeHolderName(holderName, opts); err != nil {
+			return
+		}
+	}
+
+	c.Number, c.HolderName = number, holderName
+	return
+}
+
+// Brand returns the card's brand as detected by DetectCardType.
+func (c *Card) Brand() string {
+	return DetectCardType(c.Number)
+}
+
+// Validate validates the card number, security code, expiry date at now and
+// holder name, if any. If brands are given, the card's brand must be one of them.
+//
+// If err != nil, it is a ValidationErrors with an error for each invalid field.
+func (c *Card) Validate(now time.Time, brands ...string) error {
+	var errs ValidationErrors
+
+	brand, err := ValidateCardNumber(c.Number, brands...)
+	errs = errs.append(err)
+	errs = errs.append(ValidateSecurityCode(brand, c.SecurityCode))
+	errs = errs.append(ValidateExpiry(c.ExpiryMonth, c.ExpiryYear, now))
+	if c.HolderName != "" {
+		_, _, err = NormalizeHolderName(c.HolderName, HolderNameOptions{})
+		errs = errs.append(err)
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// Mask returns the card number masked with MaskCardNumber.
+// If the card number is invalid, it is masked completely.
+func (c *Card) Mask() string {
+	masked, err := MaskCardNumber(c.Number)
+	if err != nil {
+		return strings.Repeat("*", utf8.RuneCountInString(c.Number))
+	}
+	return masked
+}
+
+// fields returns the fields to encrypt for c.
+// The security code is left out if it is empty or if the brand has CVCHidden.
+func (c *Card) fields() (map[string]string, error) {
+	number, err := NormalizeCardNumber(c.Number)
+	if err != nil {
+		return nil, err
+	}
+
+	m, y := FormatMonthYear(c.ExpiryMonth, c.ExpiryYear)
+	fields := map[string]string{
+		KeyNumber:      FormatCardNumber(number),
+		KeyExpiryMonth: m,
+		KeyExpiryYear:  y,
+	}
+	if c.SecurityCode != "" && CVCPolicyFor(DetectCardType(number)) != CVCHidden {
+		fields[KeySecurityCode] = c.SecurityCode
+	}
+	if c.HolderName != "" {
+		fields[KeyHolderName] = c.HolderName
+	}
+	return fields, nil
+}
+
+// String implements fmt.Stringer without revealing the card number or security code.
+func (c Card) String() string {
+	m, y := FormatMonthYear(c.ExpiryMonth, c.ExpiryYear)
+	return fmt.Sprintf("%s %s %s/%s", c.Brand(), c.Mask(), m, y)
+}
+
+// GoString implements fmt.GoStringer without revealing the card number or security code.
+func (c Card) GoString() string {
+	securityCode := ""
+	if c.SecurityCode != "" {
+		securityCode = "***"
+	}
+	return fmt.Sprintf(
+		"adyen.Card{Number:%q, SecurityCode:%q, ExpiryMonth:%d, ExpiryYear:%d, HolderName:%q}",
+		c.Mask(), securityCode, c.ExpiryMonth, c.ExpiryYear, c.HolderName,
+	)
+}
+
+// MarshalJSON implements json.Marshaler. The card number is masked,
+// the security code is left out and the brand is added.
+func (c Card) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Brand       string     `json:"brand"`
+		Number      string     `json:"number"`
+		ExpiryMonth time.Month `json:"expiryMonth"`
+		ExpiryYear  int        `json:"expiryYear"`
+		HolderName  string     `json:"holderName,omitempty"`
+	}{c.Brand(), c.Mask(), c.ExpiryMonth, c.ExpiryYear, c.HolderName})
+}
+
+// EncryptCard encrypts all fields of c together, like Encrypt.
+// The security code is left out if it is empty or if the brand has CVCHidden.
+func (enc *Encrypter) EncryptCard(c *Card) (string, error) {
+	fields, err := c.fields()
+	if err != nil {
+		return "", err
+	}
+	return enc.EncryptFields(fields)
+}
+
+// EncryptCardFields encrypts the card number, expiry month, expiry year and
+// security code of c one by one. The security code is left out if it is empty
+// or if the brand has CVCHidden.
+//
+// The holder name is not encrypted, since Adyen expects it in plaintext
+// next to the encrypted fields.
+func (enc *Encrypter) EncryptCardFields(c *Card) (encrypted EncryptedCard, err error) {
+	fields, err := c.fields()
+	if err != nil {
+		return
+	}
+
+	for key, dst := range map[string]*string{
+		KeyNumber:       &encrypted.EncryptedCardNumber,
+		KeyExpiryMonth:  &encrypted.EncryptedExpiryMonth,
+		KeyExpiryYear:   &encrypted.EncryptedExpiryYear,
+		KeySecurityCode: &encrypted.EncryptedSecurityCode,
+	} {
+		value, ok := fields[key]
+		if !ok {
+			continue
+		}
+		if *dst, err = enc.EncryptField(key, value); err != nil {
+			return EncryptedCard{}, err
+		}
+	}
+	return
+}
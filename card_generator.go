@@ -0,0 +1,108 @@
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package adyen
+
+import (
+	"errors"
+	"math/rand"
+	"strconv"
+)
+
+// maxGenerateAttempts is how many random card numbers CardGenerator.Generate
+// tries before it gives up.
+const maxGenerateAttempts = 1000
+
+// A CardGenerator generates random card numbers for testing.
+//
+// The card numbers are Luhn-valid (unless the brand does not use the Luhn
+// algorithm) and are detected as the brand they were generated for.
+// For a brand that DetectCardType never returns (see BrandTable.Unreachable),
+// they are detected as the brand by BrandTable.DetectAllowed when it is allowed.
+// Since math/rand is used, they must never be used as secrets.
+type CardGenerator struct {
+	rand *rand.Rand
+}
+
+// NewCardGenerator creates a new CardGenerator that uses src as its random source.
+// Generators with sources seeded the same way generate the same card numbers.
+func NewCardGenerator(src rand.Source) *CardGenerator {
+	return &CardGenerator{rand: rand.New(src)}
+}
+
+// Generate generates a card number of the given brand with the optional length.
+// If no length is given, a random permitted length of the brand is used.
+//
+// An error is returned if the brand is unknown or if no card number of the
+// brand and length can be detected as the brand.
+func (g *CardGenerator) Generate(brand string, length ...int) (string, error) {
+	b, ok := Brands.Find(brand)
+	if !ok {
+		return "", errors.New("adyen: unknown brand " + brand)
+	}
+
+	lengths := b.PermittedLengths
+	if len(length) > 0 {
+		lengths = length[:1]
+	}
+
+	var allowed string
+	buf := make([]byte, 0, 19)
+	for i := 0; i < maxGenerateAttempts; i++ {
+		l := lengths[g.rand.Intn(len(lengths))]
+		prefix := strconv.Itoa(b.StartingRules[g.rand.Intn(len(b.StartingRules))])
+		if len(prefix) >= l {
+			continue
+		}
+
+		buf = append(buf[:0], prefix...)
+		for len(buf) < l-1 {
+			buf = append(buf, '0'+byte(g.rand.Intn(10)))
+		}
+		if noLuhnBrands[brand] {
+			buf = append(buf, '0'+byte(g.rand.Intn(10)))
+		} else {
+			buf = append(buf, luhnCheckDigit(string(buf)))
+		}
+
+		number := string(buf)
+		if DetectCardType(number) == brand {
+			return number, nil
+		}
+		if allowed == "" && Brands.DetectAllowed(number, brand) == brand {
+			allowed = number
+		}
+	}
+
+	if allowed != "" {
+		return allowed, nil
+	}
+
+	return "", errors.New("adyen: cannot generate a card number for " + brand)
+}
+
+// luhnCheckDigit returns the check digit to append to digits to make it Luhn-valid.
+func luhnCheckDigit(digits string) byte {
+	return '0' + byte((10-luhnSum(digits+"0")%10)%10)
+}
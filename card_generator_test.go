@@ -0,0 +1,74 @@
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package adyen
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestCardGenerator(t *testing.T) {
+	g := NewCardGenerator(rand.NewSource(1))
+
+	for _, brand := range Brands {
+		for i := 0; i < 100; i++ {
+			number, err := g.Generate(brand.Type)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if detected, err := ValidateCardNumber(number, brand.Type); err != nil || detected != brand.Type {
+				t.Fatalf("%s should be a valid %s, instead got %s (%v)\n", number, brand.Type, detected, err)
+			}
+		}
+	}
+}
+
+func TestCardGenerator_Length(t *testing.T) {
+	g := NewCardGenerator(rand.NewSource(1))
+
+	number, err := g.Generate("visa", 13)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(number) != 13 {
+		t.Fatalf("%s should have 13 digits\n", number)
+	}
+
+	if _, err = g.Generate("amex", 16); err == nil {
+		t.Fatal("expected an error for a 16-digit amex")
+	}
+	if _, err = g.Generate("unknown"); err == nil {
+		t.Fatal("expected an error for an unknown brand")
+	}
+}
+
+func TestCardGenerator_Seed(t *testing.T) {
+	a, _ := NewCardGenerator(rand.NewSource(42)).Generate("mc")
+	b, _ := NewCardGenerator(rand.NewSource(42)).Generate("mc")
+	if a != b {
+		t.Fatalf("%s and %s should be equal\n", a, b)
+	}
+}
@@ -0,0 +1,166 @@
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package adyen
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCard_Validate(t *testing.T) {
+	now := time.Date(2024, time.June, 15, 12, 0, 0, 0, time.UTC)
+
+	c := &Card{
+		Number:       "5123 4590 4605 8920",
+		SecurityCode: "737",
+		ExpiryMonth:  time.March,
+		ExpiryYear:   2030,
+		HolderName:   "John Smith",
+	}
+	if err := c.Validate(now); err != nil {
+		t.Fatal(err)
+	}
+	if brand := c.Brand(); brand != "mc" {
+		t.Fatalf("expected mc, got %s\n", brand)
+	}
+
+	c = &Card{Number: "5123459046058921", SecurityCode: "73", ExpiryMonth: time.March, ExpiryYear: 2020}
+	var errs ValidationErrors
+	if err := c.Validate(now); !errors.As(err, &errs) {
+		t.Fatalf("expected ValidationErrors, got %v\n", err)
+	}
+	if len(errs) != 3 || errs[0].Code != ErrCardNumberInvalid || errs[1].Code != ErrSecurityCodeIncomplete || errs[2].Code != ErrExpiryTooOld {
+		t.Fatalf("unexpected errors %v\n", errs)
+	}
+
+	c = &Card{Number: "4871049999999910", SecurityCode: "737", ExpiryMonth: time.March, ExpiryYear: 2030}
+	if err := c.Validate(now, "visa"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCard_Normalize(t *testing.T) {
+	c := &Card{Number: "5123-4590-4605-8920", HolderName: " John  Smith "}
+	changes, err := c.Normalize(HolderNameOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Number != "5123459046058920" || c.HolderName != "John Smith" || !changes.Trimmed || !changes.Collapsed {
+		t.Fatalf("unexpected card %#v and changes %+v\n", c, changes)
+	}
+
+	c = &Card{Number: "5123x", HolderName: " John "}
+	if _, err = c.Normalize(HolderNameOptions{}); err == nil || c.HolderName != " John " {
+		t.Fatal("expected an error and no changes")
+	}
+}
+
+func TestCard_Redacted(t *testing.T) {
+	c := Card{
+		Number:       "5123459046058920",
+		SecurityCode: "737",
+		ExpiryMonth:  time.March,
+		ExpiryYear:   2030,
+		HolderName:   "John Smith",
+	}
+
+	b, err := json.Marshal(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, s := range []string{
+		c.String(),
+		c.GoString(),
+		fmt.Sprint(c),
+		fmt.Sprintf("%v %+v %#v %s", c, &c, c, &c),
+		string(b),
+	} {
+		if strings.Contains(s, c.Number) || strings.Contains(s, c.SecurityCode) {
+			t.Fatalf("%s reveals the card number or security code\n", s)
+		}
+		if !strings.Contains(s, "51234590****8920") {
+			t.Fatalf("%s does not contain the masked card number\n", s)
+		}
+	}
+
+	if s := c.String(); s != "mc 51234590****8920 03/2030" {
+		t.Fatalf("unexpected string %s\n", s)
+	}
+	if s := string(b); s != `{"brand":"mc","number":"51234590****8920","expiryMonth":3,"expiryYear":2030,"holderName":"John Smith"}` {
+		t.Fatalf("unexpected JSON %s\n", s)
+	}
+}
+
+func TestEncrypter_EncryptCard(t *testing.T) {
+	enc, key := newTestEncrypter(t)
+
+	c := &Card{
+		Number:       "5123459046058920",
+		SecurityCode: "737",
+		ExpiryMonth:  time.March,
+		ExpiryYear:   2030,
+		HolderName:   "John Smith",
+	}
+
+	payload, err := enc.EncryptCard(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fields := decrypt(t, key, payload)
+	if fields[KeyNumber] != "5123 4590 4605 8920" || fields[KeySecurityCode] != "737" ||
+		fields[KeyExpiryMonth] != "03" || fields[KeyExpiryYear] != "2030" || fields[KeyHolderName] != "John Smith" {
+		t.Fatalf("unexpected fields %v\n", fields)
+	}
+
+	encrypted, err := enc.EncryptCardFields(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for payload, expected := range map[string][2]string{
+		encrypted.EncryptedCardNumber:   {KeyNumber, "5123 4590 4605 8920"},
+		encrypted.EncryptedExpiryMonth:  {KeyExpiryMonth, "03"},
+		encrypted.EncryptedExpiryYear:   {KeyExpiryYear, "2030"},
+		encrypted.EncryptedSecurityCode: {KeySecurityCode, "737"},
+	} {
+		fields = decrypt(t, key, payload)
+		if len(fields) != 2 || fields[expected[0]] != expected[1] || fields[GenerationTimeKey] == "" {
+			t.Fatalf("unexpected fields %v\n", fields)
+		}
+	}
+
+	// bancontact cards have no security code.
+	c.Number = "6703444444444449"
+	if encrypted, err = enc.EncryptCardFields(c); err != nil {
+		t.Fatal(err)
+	}
+	if encrypted.EncryptedSecurityCode != "" {
+		t.Fatal("expected no security code")
+	}
+}
@@ -26,122 +26,152 @@ package adyen
 
 import "regexp"
 
-var (
-	mastercardPattern         = regexp.MustCompile(`^(5[1-5]\d{0,14}|2[2-7]\d{0,14})$`)
-	visadankortPattern        = regexp.MustCompile(`^(4571)\d{0,12}$`)
-	visaPattern               = regexp.MustCompile(`^4\d{0,18}$`)
-	amexPattern               = regexp.MustCompile(`^3[47]\d{0,13}$`)
-	dinersPattern             = regexp.MustCompile(`^(36)\d{0,12}$`)
-	maestroukPattern          = regexp.MustCompile(`^(6759)\d{0,15}$`)
-	soloPattern               = regexp.MustCompile(`^(6767)\d{0,15}$`)
-	laserPattern              = regexp.MustCompile(`^(6304|6706|6709|6771)\d{0,15}$`)
-	discoverPattern           = regexp.MustCompile(`^(6011\d{0,12}|(644|645|646|647|648|649)\d{0,13}|65\d{0,14})$`)
-	jcbPattern                = regexp.MustCompile(`^(352[8,9]\d{0,15}|35[4-8]\d{0,16})$`)
-	bcmcPattern               = regexp.MustCompile(`^((6703)\d{0,15}|(479658|606005)\d{0,13})$`)
-	bijcardPattern            = regexp.MustCompile(`^(5100081)\d{0,9}$`)
-	dankortPattern            = regexp.MustCompile(`^(5019)\d{0,12}$`)
-	hipercardPattern          = regexp.MustCompile(`^(606282)\d{0,10}$`)
-	cupPattern                = regexp.MustCompile(`^(62|81)\d{0,17}$`)
-	maestroPattern            = regexp.MustCompile(`^(5[0|6-8]\d{0,17}|6\d{0,18})$`)
-	eloPattern                = regexp.MustCompile(`^((((506699)|(506770)|(506771)|(506772)|(506773)|(506774)|(506775)|(506776)|(506777)|(506778)|(401178)|(438935)|(451416)|(457631)|(457632)|(504175)|(627780)|(636368)|(636297))\d{0,10})|((50676)|(50675)|(50674)|(50673)|(50672)|(50671)|(50670))\d{0,11})$`)
-	uatpPattern               = regexp.MustCompile(`^1\d{0,14}$`)
-	cartebancairePattern      = regexp.MustCompile(`^[4-6]\d{0,15}$`)
-	visaAlphaBankBonusPattern = regexp.MustCompile(`^(450903)\d{0,10}$`)
-	mcAlphaBankBonusPattern   = regexp.MustCompile(`^(510099)\d{0,10}$`)
-	hiperPattern              = regexp.MustCompile(`^(637095|637568|637599|637609|637612)\d{0,10}$`)
-	oasisPattern              = regexp.MustCompile(`^(982616)\d{0,10}$`)
-	karenMillenPattern        = regexp.MustCompile(`^(98261465)\d{0,8}$`)
-	warehousePattern          = regexp.MustCompile(`^(982633)\d{0,10}$`)
-	mirPattern                = regexp.MustCompile(`^(220)\d{0,16}$`)
-	codensaPattern            = regexp.MustCompile(`^(590712)\d{0,10}$`)
-	naranjaPattern            = regexp.MustCompile(`^(37|40|5[28])([279])\d*$`)
-	cabalPattern              = regexp.MustCompile(`^(58|6[03])([03469])\d*$`)
-	shoppingPattern           = regexp.MustCompile(`^(27|58|60)([39])\d*$`)
-	argenCardPattern          = regexp.MustCompile(`^(50)(1)\d*$`)
-	troyPattern               = regexp.MustCompile(`^(97)(9)\d*$`)
-	forbrugsforeningenPattern = regexp.MustCompile(`^(60)(0)\d*$`)
-	vpayPattern               = regexp.MustCompile(`^(40[1,8]|413|43[4,5]|44[1,23467]|45[5,8]|46[0,136]|47[1,9]|48[2,37])\d{0,16}$`)
-	rupayPattern              = regexp.MustCompile(`^(100003|508(2|[5-9])|60(69|[7-8])|652(1[5-9]|[2-5]\d|8[5-9])|65300[3-4]|8172([0-1]|[3-5]|7|9)|817(3[3-8]|40[6-9]|410)|35380([0-2]|[5-6]|9))\d{0,12}$`)
-)
+//go:generate go run gen_card_types.go
 
-// DetectCardType detects the type of the given card number.
+// NoBrand is the card type returned by DetectCardType when the card's
+// type cannot be detected.
+const NoBrand = "noBrand"
+
+// A Brand describes a card brand as defined by adyen-web.
+type Brand struct {
+	// Type is the brand code that Adyen uses, like "mc" or "visa".
+	Type string
+
+	// StartingRules are the card number prefixes that belong to the brand.
+	StartingRules []int
+
+	// PermittedLengths are the lengths that a complete card number
+	// of the brand can have.
+	PermittedLengths []int
+
+	// Pattern matches both partial and complete card numbers of the brand.
+	Pattern *regexp.Regexp
+
+	// SecurityCode is the name that the brand uses for its security code,
+	// like "CVV" or "CID". It is empty if the brand uses the generic name.
+	SecurityCode string
+
+	// CVCPolicy is the brand's security code policy.
+	// It is empty if the security code is required, so use Policy to read it.
+	CVCPolicy CVCPolicy
+}
+
+// A BrandTable is an ordered list of brands.
+//
+// When more than one brand matches a card number, the first one wins.
+type BrandTable []Brand
+
+// Detect detects the type of the given card number using the brands in t.
 // The card number must have no whitespace characters.
 //
+// If the card's type cannot be detected, then NoBrand is returned.
+func (t BrandTable) Detect(formattedCardNumber string) string {
+	if i := t.detect(formattedCardNumber); i >= 0 {
+		return t[i].Type
+	}
+	return NoBrand
+}
+
+// DetectAllowed detects the type of the given card number using only the
+// brands in t whose type is in allowed, like adyen-web's detectCard does with
+// the available cards. The card number must have no whitespace characters.
+//
+// When more than one allowed brand matches, the brand with the longest starting
+// rule that the card number begins with wins, so that a co-brand or local brand
+// like "elo" is detected instead of the network whose wider range it is in.
+// If there is still more than one, the first one wins.
+// If allowed is empty, DetectAllowed is the same as Detect.
+//
+// If the card's type cannot be detected, then NoBrand is returned.
+func (t BrandTable) DetectAllowed(formattedCardNumber string, allowed ...string) string {
+	if len(allowed) == 0 {
+		return t.Detect(formattedCardNumber)
+	}
+
+	match, matchRule := -1, -1
+	for i := range t {
+		if !contains(allowed, t[i].Type) || !t[i].Pattern.MatchString(formattedCardNumber) {
+			continue
+		}
+		if rule := t[i].ruleLen(formattedCardNumber); rule > matchRule {
+			match, matchRule = i, rule
+		}
+	}
+	if match < 0 {
+		return NoBrand
+	}
+	return t[match].Type
+}
+
+// Find returns the brand in t with the given type.
+func (t BrandTable) Find(brandType string) (Brand, bool) {
+	for _, brand := range t {
+		if brand.Type == brandType {
+			return brand, true
+		}
+	}
+	return Brand{}, false
+}
+
+// detect returns the index of the brand detected for number, or -1.
+func (t BrandTable) detect(number string) int {
+	for i := range t {
+		if t[i].Pattern.MatchString(number) {
+			return i
+		}
+	}
+	return -1
+}
+
+// ruleLen returns the length of the longest starting rule that number begins with.
+// Zero is returned if number does not begin with any of them.
+func (b *Brand) ruleLen(number string) (n int) {
+	for _, rule := range b.StartingRules {
+		if l := ruleMatch(number, rule); l > n {
+			n = l
+		}
+	}
+	return
+}
+
+// ruleMatch returns the number of digits in rule if number begins with rule,
+// otherwise zero.
+func ruleMatch(number string, rule int) int {
+	l := 1
+	for r := rule / 10; r > 0; r /= 10 {
+		l++
+	}
+	if len(number) < l {
+		return 0
+	}
+
+	for i := l - 1; i >= 0; i-- {
+		if int(number[i]-'0') != rule%10 {
+			return 0
+		}
+		rule /= 10
+	}
+	return l
+}
+
+// DetectCardType detects the type of the given card number.
+// The card number is normalized with NormalizeCardNumber first.
+//
 // If the card's type cannot be detected, then "noBrand" is returned
 // which is also what Adyen uses if it cannot detect the card type.
 func DetectCardType(formattedCardNumber string) string {
-	switch {
-	case mastercardPattern.MatchString(formattedCardNumber):
-		return "mc"
-	case visadankortPattern.MatchString(formattedCardNumber):
-		return "visadankort"
-	case visaPattern.MatchString(formattedCardNumber):
-		return "visa"
-	case amexPattern.MatchString(formattedCardNumber):
-		return "amex"
-	case dinersPattern.MatchString(formattedCardNumber):
-		return "diners"
-	case maestroukPattern.MatchString(formattedCardNumber):
-		return "maestrouk"
-	case soloPattern.MatchString(formattedCardNumber):
-		return "solo"
-	case laserPattern.MatchString(formattedCardNumber):
-		return "laser"
-	case discoverPattern.MatchString(formattedCardNumber):
-		return "discover"
-	case jcbPattern.MatchString(formattedCardNumber):
-		return "jcb"
-	case bcmcPattern.MatchString(formattedCardNumber):
-		return "bcmc"
-	case bijcardPattern.MatchString(formattedCardNumber):
-		return "bijcard"
-	case dankortPattern.MatchString(formattedCardNumber):
-		return "dankort"
-	case hipercardPattern.MatchString(formattedCardNumber):
-		return "hiper"
-	case cupPattern.MatchString(formattedCardNumber):
-		return "cup"
-	case maestroPattern.MatchString(formattedCardNumber):
-		return "maestro"
-	case eloPattern.MatchString(formattedCardNumber):
-		return "elo"
-	case uatpPattern.MatchString(formattedCardNumber):
-		return "uatp"
-	case cartebancairePattern.MatchString(formattedCardNumber):
-		return "cartebancaire"
-	case visaAlphaBankBonusPattern.MatchString(formattedCardNumber):
-		return "visaalphabankbonus"
-	case mcAlphaBankBonusPattern.MatchString(formattedCardNumber):
-		return "mcalphabankbonus"
-	case hiperPattern.MatchString(formattedCardNumber):
-		return "hiper"
-	case oasisPattern.MatchString(formattedCardNumber):
-		return "oasis"
-	case karenMillenPattern.MatchString(formattedCardNumber):
-		return "karenmillen"
-	case warehousePattern.MatchString(formattedCardNumber):
-		return "warehouse"
-	case mirPattern.MatchString(formattedCardNumber):
-		return "mir"
-	case codensaPattern.MatchString(formattedCardNumber):
-		return "codensa"
-	case naranjaPattern.MatchString(formattedCardNumber):
-		return "naranja"
-	case cabalPattern.MatchString(formattedCardNumber):
-		return "cabal"
-	case shoppingPattern.MatchString(formattedCardNumber):
-		return "shopping"
-	case argenCardPattern.MatchString(formattedCardNumber):
-		return "argencard"
-	case troyPattern.MatchString(formattedCardNumber):
-		return "troy"
-	case forbrugsforeningenPattern.MatchString(formattedCardNumber):
-		return "forbrugsforeningen"
-	case vpayPattern.MatchString(formattedCardNumber):
-		return "vpay"
-	case rupayPattern.MatchString(formattedCardNumber):
-		return "rupay"
-	default:
-		return "noBrand"
+	number, err := NormalizeCardNumber(formattedCardNumber)
+	if err != nil {
+		return NoBrand
 	}
+	return detector.Detect(number)
 }
+
+// detector is the compiled form of Brands used by DetectCardType.
+var detector = func() *Detector {
+	d, err := Brands.Compile()
+	if err != nil {
+		panic(err)
+	}
+	return d
+}()
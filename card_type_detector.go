@@ -0,0 +1,302 @@
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package adyen
+
+import (
+	"fmt"
+	"regexp/syntax"
+	"sort"
+)
+
+// A Detector detects card types like BrandTable.Detect, but uses a digit trie
+// compiled from the brand table instead of running each pattern in turn.
+//
+// A Detector is safe for concurrent use and Detect does not allocate.
+type Detector struct {
+	types []string
+	nodes []detectorNode
+}
+
+// detectorNode is a node in the trie of a Detector.
+type detectorNode struct {
+	// next holds the index of the child node for each digit,
+	// or zero if there is none.
+	next [10]int32
+
+	// candidates are the brands that a card number reaching this node can be,
+	// ordered by precedence.
+	candidates []detectorCandidate
+}
+
+// detectorCandidate is a brand that is detected if the card number's length
+// is between minLen and maxLen. maxLen is -1 if there is no upper bound.
+type detectorCandidate struct {
+	brand          int
+	minLen, maxLen int
+}
+
+// patternBranch is a single branch of a brand's pattern: a digit prefix
+// followed by between minTail and maxTail digits. maxTail is -1 if there
+// is no upper bound.
+type patternBranch struct {
+	prefix           string
+	minTail, maxTail int
+}
+
+// Compile compiles t into a Detector.
+//
+// Every pattern in t must be anchored at both ends and consist of digit
+// literals, digit classes, alternations and groups, optionally followed by
+// a repetition of [0-9] or \d. An error is returned for any other pattern.
+func (t BrandTable) Compile() (*Detector, error) {
+	d := &Detector{
+		types: make([]string, len(t)),
+		nodes: make([]detectorNode, 1),
+	}
+
+	// terminals holds, per node, the pattern branches that end at that node.
+	terminals := make(map[int32][]detectorCandidate)
+
+	for i := range t {
+		d.types[i] = t[i].Type
+
+		branches, err := patternBranches(t[i].Pattern.String())
+		if err != nil {
+			return nil, fmt.Errorf("adyen: brand %s: %w", t[i].Type, err)
+		}
+
+		for _, b := range branches {
+			node := d.insert(b.prefix)
+			c := detectorCandidate{
+				brand:  i,
+				minLen: len(b.prefix) + b.minTail,
+				maxLen: -1,
+			}
+			if b.maxTail >= 0 {
+				c.maxLen = len(b.prefix) + b.maxTail
+			}
+			terminals[node] = append(terminals[node], c)
+		}
+	}
+
+	// walk the trie and give every node the candidates of its path.
+	var walk func(node int32, candidates []detectorCandidate)
+	walk = func(node int32, candidates []detectorCandidate) {
+		candidates = append(candidates[:len(candidates):len(candidates)], terminals[node]...)
+
+		own := append([]detectorCandidate(nil), candidates...)
+		sort.SliceStable(own, func(i, j int) bool { return own[i].brand < own[j].brand })
+		d.nodes[node].candidates = own
+
+		for _, child := range d.nodes[node].next {
+			if child != 0 {
+				walk(child, candidates)
+			}
+		}
+	}
+	walk(0, nil)
+
+	return d, nil
+}
+
+// insert returns the node for prefix, creating it and its parents if needed.
+func (d *Detector) insert(prefix string) int32 {
+	var node int32
+	for i := 0; i < len(prefix); i++ {
+		digit := prefix[i] - '0'
+		next := d.nodes[node].next[digit]
+		if next == 0 {
+			next = int32(len(d.nodes))
+			d.nodes = append(d.nodes, detectorNode{})
+			d.nodes[node].next[digit] = next
+		}
+		node = next
+	}
+	return node
+}
+
+// Detect detects the type of the given card number.
+// The card number must consist of digits only.
+//
+// If the card's type cannot be detected, then NoBrand is returned.
+func (d *Detector) Detect(formattedCardNumber string) string {
+	var node int32
+	walking := true
+	for i := 0; i < len(formattedCardNumber); i++ {
+		digit := formattedCardNumber[i] - '0'
+		if digit > 9 {
+			return NoBrand
+		}
+
+		// once the trie ends, the remaining digits only count towards the length.
+		if walking {
+			if next := d.nodes[node].next[digit]; next != 0 {
+				node = next
+			} else {
+				walking = false
+			}
+		}
+	}
+
+	n := len(formattedCardNumber)
+	for _, c := range d.nodes[node].candidates {
+		if n >= c.minLen && (c.maxLen < 0 || n <= c.maxLen) {
+			return d.types[c.brand]
+		}
+	}
+	return NoBrand
+}
+
+// patternBranches parses pattern and splits it into its branches.
+func patternBranches(pattern string) ([]patternBranch, error) {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return nil, err
+	}
+
+	// the pattern must be ^...$
+	if re.Op != syntax.OpConcat || len(re.Sub) < 2 ||
+		re.Sub[0].Op != syntax.OpBeginText || re.Sub[len(re.Sub)-1].Op != syntax.OpEndText {
+		return nil, fmt.Errorf("pattern %s is not anchored", pattern)
+	}
+
+	return expand(&syntax.Regexp{Op: syntax.OpConcat, Sub: re.Sub[1 : len(re.Sub)-1]})
+}
+
+// expand expands re into pattern branches.
+// Branches that can never match a digit are left out.
+func expand(re *syntax.Regexp) ([]patternBranch, error) {
+	switch re.Op {
+	case syntax.OpEmptyMatch:
+		return []patternBranch{{}}, nil
+
+	case syntax.OpLiteral:
+		for _, r := range re.Rune {
+			if r < '0' || r > '9' {
+				return nil, nil
+			}
+		}
+		return []patternBranch{{prefix: string(re.Rune)}}, nil
+
+	case syntax.OpCharClass:
+		var branches []patternBranch
+		for _, digit := range classDigits(re) {
+			branches = append(branches, patternBranch{prefix: string(digit)})
+		}
+		return branches, nil
+
+	case syntax.OpCapture:
+		return expand(re.Sub[0])
+
+	case syntax.OpAlternate:
+		var branches []patternBranch
+		for _, sub := range re.Sub {
+			b, err := expand(sub)
+			if err != nil {
+				return nil, err
+			}
+			branches = append(branches, b...)
+		}
+		return branches, nil
+
+	case syntax.OpConcat:
+		branches := []patternBranch{{}}
+		for _, sub := range re.Sub {
+			next, err := expand(sub)
+			if err != nil {
+				return nil, err
+			}
+
+			var joined []patternBranch
+			for _, a := range branches {
+				for _, b := range next {
+					if a.maxTail != 0 && b.prefix != "" {
+						return nil, fmt.Errorf("digits after repetition in %s", re)
+					}
+					j := patternBranch{
+						prefix:  a.prefix + b.prefix,
+						minTail: a.minTail + b.minTail,
+						maxTail: a.maxTail + b.maxTail,
+					}
+					if a.maxTail < 0 || b.maxTail < 0 {
+						j.maxTail = -1
+					}
+					joined = append(joined, j)
+				}
+			}
+			branches = joined
+		}
+		return branches, nil
+
+	case syntax.OpStar, syntax.OpPlus, syntax.OpQuest, syntax.OpRepeat:
+		min, max := re.Min, re.Max
+		switch re.Op {
+		case syntax.OpStar:
+			min, max = 0, -1
+		case syntax.OpPlus:
+			min, max = 1, -1
+		case syntax.OpQuest:
+			min, max = 0, 1
+		}
+
+		if isAnyDigit(re.Sub[0]) {
+			return []patternBranch{{minTail: min, maxTail: max}}, nil
+		}
+
+		// a fixed number of repetitions is the same as concatenation.
+		if min == max {
+			sub := make([]*syntax.Regexp, min)
+			for i := range sub {
+				sub[i] = re.Sub[0]
+			}
+			return expand(&syntax.Regexp{Op: syntax.OpConcat, Sub: sub})
+		}
+	}
+
+	return nil, fmt.Errorf("unsupported expression %s", re)
+}
+
+// classDigits returns the digits in the character class re.
+func classDigits(re *syntax.Regexp) []rune {
+	var digits []rune
+	for i := 0; i < len(re.Rune); i += 2 {
+		lo, hi := re.Rune[i], re.Rune[i+1]
+		if lo < '0' {
+			lo = '0'
+		}
+		if hi > '9' {
+			hi = '9'
+		}
+		for r := lo; r <= hi; r++ {
+			digits = append(digits, r)
+		}
+	}
+	return digits
+}
+
+// isAnyDigit reports whether re matches exactly one digit of any value.
+func isAnyDigit(re *syntax.Regexp) bool {
+	return re.Op == syntax.OpCharClass && len(re.Rune) == 2 && re.Rune[0] == '0' && re.Rune[1] == '9'
+}
@@ -0,0 +1,120 @@
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package adyen
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// maxTestLength is the longest card number used to compare detectors.
+// It is longer than any permitted length so that length limits are covered.
+const maxTestLength = 21
+
+func TestDetector_Equivalence(t *testing.T) {
+	d, err := Brands.Compile()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	test := func(number string) {
+		expected := firstMatch(number)
+		if got := d.Detect(number); got != expected {
+			t.Fatalf("%s should be %s, instead got %s\n", number, expected, got)
+		}
+		if got := Brands.Detect(number); got != expected {
+			t.Fatalf("%s should be %s with the brand table, instead got %s\n", number, expected, got)
+		}
+	}
+
+	// every short number.
+	for n := 1; n <= 10000; n *= 10 {
+		for i := 0; i < n*10; i++ {
+			test(strconv.Itoa(i + n*10)[1:])
+		}
+	}
+
+	// every prefix in the trie, followed by each digit, at every length.
+	var walk func(node int32, prefix string)
+	walk = func(node int32, prefix string) {
+		for digit, next := range d.nodes[node].next {
+			p := prefix + strconv.Itoa(digit)
+			for length := len(p); length <= maxTestLength; length++ {
+				test(p + strings.Repeat("0", length-len(p)))
+			}
+
+			if next != 0 {
+				walk(next, p)
+			}
+		}
+	}
+	walk(0, "")
+
+	test("")
+	test("4111 1111 1111 1111")
+	test("4871049999999910")
+	test("4131840000000003")
+	test("5895626746595650")
+}
+
+// firstMatch detects the type of number like the original switch statement
+// did: each pattern is tried in table order and the first match wins.
+func firstMatch(number string) string {
+	for _, brand := range Brands {
+		if brand.Pattern.MatchString(number) {
+			return brand.Type
+		}
+	}
+	return NoBrand
+}
+
+func TestDetector_Allocs(t *testing.T) {
+	if n := testing.AllocsPerRun(100, func() { DetectCardType("5123459046058920") }); n != 0 {
+		t.Fatalf("expected no allocations, got %v", n)
+	}
+}
+
+func TestPatternBranches(t *testing.T) {
+	for _, pattern := range []string{`4[0-9]*`, `^4[0-9]{0,3}5$`, `^4[0-9]+?[1-2]{1,2}$`} {
+		if _, err := patternBranches(pattern); err == nil {
+			t.Fatalf("expected %s to be unsupported", pattern)
+		}
+	}
+}
+
+func BenchmarkDetectCardType(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		DetectCardType("5123459046058920")
+	}
+}
+
+func BenchmarkBrandTable_Detect(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Brands.Detect("5123459046058920")
+	}
+}
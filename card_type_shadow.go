@@ -0,0 +1,131 @@
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package adyen
+
+import (
+	"strconv"
+	"strings"
+)
+
+// A Shadowing reports that a brand in a BrandTable is never detected for
+// one of its starting rules, either because other brands are detected
+// instead or because the rule is dead.
+type Shadowing struct {
+	// Brand is the type of the shadowed brand.
+	Brand string
+
+	// Prefix is the starting rule of Brand that is shadowed.
+	Prefix string
+
+	// By are the types of the brands that are detected instead of Brand,
+	// in table order. It is empty if Dead is true.
+	By []string
+
+	// Dead reports that the starting rule never matches the brand's own
+	// pattern and permitted lengths, so the brand cannot be detected for it
+	// even without other brands in the table.
+	Dead bool
+}
+
+// Shadowed analyzes t and reports every starting rule for which a brand
+// cannot be detected. The result is in table order.
+//
+// For each starting rule, card numbers of every permitted length are
+// built from the rule followed by a repeated digit. A starting rule is
+// shadowed if none of the numbers that match the brand's pattern are
+// detected as that brand, and dead if none of them match the pattern.
+//
+// Since only these sample numbers are checked, the result depends on which
+// brands the repeated digits happen to hit. Shadowing that starts deeper
+// than the rule is missed: a rule "4" that another brand shadows for numbers
+// starting with "4509" only is not reported, since no sample number starts
+// with "4509". A rule is only reported if every sample number that matches
+// the brand's pattern is detected as another brand.
+func (t BrandTable) Shadowed() []Shadowing {
+	var shadowed []Shadowing
+	for i := range t {
+		for _, rule := range t[i].StartingRules {
+			prefix := strconv.Itoa(rule)
+			if by, ok := t.shadowedBy(i, prefix); ok {
+				shadowed = append(shadowed, Shadowing{
+					Brand:  t[i].Type,
+					Prefix: prefix,
+					By:     by,
+					Dead:   len(by) == 0,
+				})
+			}
+		}
+	}
+	return shadowed
+}
+
+// Unreachable returns the types of the brands in t that are shadowed
+// for all of their starting rules, which means that Detect never returns them.
+func (t BrandTable) Unreachable() []string {
+	shadowed := make(map[string]int)
+	for _, s := range t.Shadowed() {
+		shadowed[s.Brand]++
+	}
+
+	var unreachable []string
+	for _, brand := range t {
+		if n, ok := shadowed[brand.Type]; ok && n == len(brand.StartingRules) {
+			unreachable = append(unreachable, brand.Type)
+		}
+	}
+	return unreachable
+}
+
+// shadowedBy reports whether the brand at index i is shadowed for prefix
+// and returns the brands that are detected instead. If no sample number
+// matches the brand's own pattern, the rule is dead and by is empty.
+func (t BrandTable) shadowedBy(i int, prefix string) (by []string, shadowed bool) {
+	detected := make([]bool, len(t))
+	for _, length := range t[i].PermittedLengths {
+		if length < len(prefix) {
+			continue
+		}
+
+		for digit := '0'; digit <= '9'; digit++ {
+			number := prefix + strings.Repeat(string(digit), length-len(prefix))
+			if !t[i].Pattern.MatchString(number) {
+				continue
+			}
+
+			j := t.detect(number)
+			if j == i {
+				return nil, false
+			}
+			detected[j] = true
+		}
+	}
+
+	for j, ok := range detected {
+		if ok {
+			by = append(by, t[j].Type)
+		}
+	}
+	return by, true
+}
@@ -0,0 +1,142 @@
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package adyen
+
+import (
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestBrands_Unreachable(t *testing.T) {
+	// these brands are only detected by DetectAllowed, when the brands
+	// that come before them in the table are not allowed or have a shorter
+	// starting rule for the card number.
+	expected := []string{"bijcard", "elo", "visaalphabankbonus", "mcalphabankbonus", "hiper",
+		"codensa", "cabal", "argencard", "forbrugsforeningen", "vpay"}
+	if unreachable := Brands.Unreachable(); !reflect.DeepEqual(unreachable, expected) {
+		for _, s := range Brands.Shadowed() {
+			if s.Dead {
+				t.Logf("%s is dead for %s", s.Brand, s.Prefix)
+			} else {
+				t.Logf("%s is shadowed for %s by %v", s.Brand, s.Prefix, s.By)
+			}
+		}
+		t.Fatalf("expected unreachable brands %v, instead got %v", expected, unreachable)
+	}
+
+	all := make([]string, len(Brands))
+	for i, brand := range Brands {
+		all[i] = brand.Type
+	}
+
+	for _, brand := range expected {
+		if !reachable(brand, all) {
+			t.Errorf("%s cannot be detected even when all brands are allowed", brand)
+		}
+	}
+}
+
+// reachable reports whether DetectAllowed detects brand for any of the
+// sample numbers built from its starting rules, like Shadowed builds them.
+func reachable(brand string, allowed []string) bool {
+	b, _ := Brands.Find(brand)
+	for _, rule := range b.StartingRules {
+		prefix := strconv.Itoa(rule)
+		for _, length := range b.PermittedLengths {
+			for digit := '0'; digit <= '9' && length >= len(prefix); digit++ {
+				number := prefix + strings.Repeat(string(digit), length-len(prefix))
+				if Brands.DetectAllowed(number, allowed...) == brand {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func TestBrands_Dead(t *testing.T) {
+	var dead []string
+	for _, s := range Brands.Shadowed() {
+		if s.Dead {
+			dead = append(dead, s.Brand+" "+s.Prefix)
+		}
+	}
+
+	// these upstream rules conflict with their brand's own pattern.
+	expected := []string{"vpay 437", "vpay 439", "rupay 607", "rupay 608",
+		"rupay 35381", "rupay 35382", "rupay 35385", "rupay 35386", "rupay 35389"}
+	if !reflect.DeepEqual(dead, expected) {
+		t.Fatalf("expected dead rules %v, instead got %v", expected, dead)
+	}
+}
+
+func TestBrandTable_Shadowed(t *testing.T) {
+	table := BrandTable{
+		{
+			Type:             "a",
+			StartingRules:    []int{4},
+			PermittedLengths: []int{16},
+			Pattern:          regexp.MustCompile(`^4[0-9]{0,15}$`),
+		},
+		{
+			Type:             "c",
+			StartingRules:    []int{4, 5},
+			PermittedLengths: []int{16},
+			Pattern:          regexp.MustCompile(`^[45][0-9]{0,15}$`),
+		},
+	}
+
+	shadowed := table.Shadowed()
+	if len(shadowed) != 1 {
+		t.Fatalf("expected 1 shadowed rule, got %v", shadowed)
+	}
+	if s := shadowed[0]; s.Brand != "c" || s.Prefix != "4" || len(s.By) != 1 || s.By[0] != "a" || s.Dead {
+		t.Fatalf("unexpected shadowing %+v", s)
+	}
+
+	// a rule outside of the brand's own pattern is dead, not shadowed.
+	table[0].StartingRules = []int{4, 5}
+	shadowed = table.Shadowed()
+	if len(shadowed) != 2 {
+		t.Fatalf("expected 2 shadowed rules, got %v", shadowed)
+	}
+	if s := shadowed[0]; s.Brand != "a" || s.Prefix != "5" || len(s.By) != 0 || !s.Dead {
+		t.Fatalf("unexpected shadowing %+v", s)
+	}
+	table[0].StartingRules = []int{4}
+
+	if unreachable := table.Unreachable(); len(unreachable) != 0 {
+		t.Fatalf("expected no unreachable brands, got %v", unreachable)
+	}
+
+	// without its own starting rule, c can never be detected.
+	table[1].StartingRules = []int{4}
+	if unreachable := table.Unreachable(); len(unreachable) != 1 || unreachable[0] != "c" {
+		t.Fatalf("expected c to be unreachable, got %v", unreachable)
+	}
+}
@@ -10,4 +10,32 @@ func TestDetectCardType(t *testing.T) {
 	if DetectCardType("4000180000000002") != "visa" {
 		t.Fail()
 	}
+
+	if DetectCardType("6062828888666688") != "hipercard" {
+		t.Fail()
+	}
+
+	if DetectCardType("0000000000000000") != NoBrand {
+		t.Fail()
+	}
+}
+
+func TestBrandTable_DetectAllowed(t *testing.T) {
+	test := func(number, expected string, allowed ...string) {
+		if brand := Brands.DetectAllowed(number, allowed...); brand != expected {
+			t.Fatalf("%s with %v should be %s, instead got %s\n", number, allowed, expected, brand)
+		}
+	}
+
+	test("4871049999999910", "visa")
+	test("4871049999999910", "visa", "visa", "mc")
+	test("4131840000000003", "visa", "visa", "mc")
+	test("4871049999999910", "vpay", "visa", "vpay")
+	test("4509030000000000", "visa", "visa", "mc")
+	test("4509030000000000", "visaalphabankbonus", "visa", "visaalphabankbonus")
+	test("5100081000000000", "bijcard", "mc", "bijcard")
+	test("5066990000000000", "elo", "maestro", "elo")
+	test("5066990000000000", "maestro", "maestro", "mc")
+	test("5555555555554444", "mc", "mc", "maestro")
+	test("5555555555554444", NoBrand, "visa")
 }
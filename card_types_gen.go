@@ -0,0 +1,233 @@
+// Code generated by gen_card_types.go from third_party/adyen-web/cardType.ts; DO NOT EDIT.
+
+package adyen
+
+import "regexp"
+
+// Brands is the brand table used by DetectCardType, in the same order as adyen-web.
+//
+// It must not be modified.
+var Brands = BrandTable{
+	{
+		Type:             "mc",
+		StartingRules:    []int{51, 52, 53, 54, 55, 22, 23, 24, 25, 26, 27},
+		PermittedLengths: []int{16},
+		Pattern:          regexp.MustCompile(`^(5[1-5][0-9]{0,14}|2[2-7][0-9]{0,14})$`),
+		SecurityCode:     "CVC",
+	},
+	{
+		Type:             "visadankort",
+		StartingRules:    []int{4571},
+		PermittedLengths: []int{16},
+		Pattern:          regexp.MustCompile(`^(4571)[0-9]{0,12}$`),
+	},
+	{
+		Type:             "visa",
+		StartingRules:    []int{4},
+		PermittedLengths: []int{13, 16, 19},
+		Pattern:          regexp.MustCompile(`^4[0-9]{0,18}$`),
+		SecurityCode:     "CVV",
+	},
+	{
+		Type:             "amex",
+		StartingRules:    []int{34, 37},
+		PermittedLengths: []int{15},
+		Pattern:          regexp.MustCompile(`^3[47][0-9]{0,13}$`),
+		SecurityCode:     "CID",
+	},
+	{
+		Type:             "diners",
+		StartingRules:    []int{36},
+		PermittedLengths: []int{14, 16},
+		Pattern:          regexp.MustCompile(`^(36)[0-9]{0,12}$`),
+	},
+	{
+		Type:             "maestrouk",
+		StartingRules:    []int{6759},
+		PermittedLengths: []int{16, 18, 19},
+		Pattern:          regexp.MustCompile(`^(6759)[0-9]{0,15}$`),
+	},
+	{
+		Type:             "solo",
+		StartingRules:    []int{6767},
+		PermittedLengths: []int{16, 18, 19},
+		Pattern:          regexp.MustCompile(`^(6767)[0-9]{0,15}$`),
+	},
+	{
+		Type:             "laser",
+		StartingRules:    []int{6304, 6706, 6709, 6771},
+		PermittedLengths: []int{16, 17, 18, 19},
+		Pattern:          regexp.MustCompile(`^(6304|6706|6709|6771)[0-9]{0,15}$`),
+		CVCPolicy:        "optional",
+	},
+	{
+		Type:             "discover",
+		StartingRules:    []int{6011, 644, 645, 646, 647, 648, 649, 65},
+		PermittedLengths: []int{16},
+		Pattern:          regexp.MustCompile(`^(6011[0-9]{0,12}|(644|645|646|647|648|649)[0-9]{0,13}|65[0-9]{0,14})$`),
+	},
+	{
+		Type:             "jcb",
+		StartingRules:    []int{3528, 3529, 354, 355, 356, 357, 358},
+		PermittedLengths: []int{16, 19},
+		Pattern:          regexp.MustCompile(`^(352[8,9]{1}[0-9]{0,15}|35[4-8]{1}[0-9]{0,16})$`),
+		SecurityCode:     "CAV",
+	},
+	{
+		Type:             "bcmc",
+		StartingRules:    []int{6703, 479658, 606005},
+		PermittedLengths: []int{16, 17, 18, 19},
+		Pattern:          regexp.MustCompile(`^((6703)[0-9]{0,15}|(479658|606005)[0-9]{0,13})$`),
+		CVCPolicy:        "hidden",
+	},
+	{
+		Type:             "bijcard",
+		StartingRules:    []int{5100081},
+		PermittedLengths: []int{16},
+		Pattern:          regexp.MustCompile(`^(5100081)[0-9]{0,9}$`),
+	},
+	{
+		Type:             "dankort",
+		StartingRules:    []int{5019},
+		PermittedLengths: []int{16},
+		Pattern:          regexp.MustCompile(`^(5019)[0-9]{0,12}$`),
+	},
+	{
+		Type:             "hipercard",
+		StartingRules:    []int{606282},
+		PermittedLengths: []int{16},
+		Pattern:          regexp.MustCompile(`^(606282)[0-9]{0,10}$`),
+	},
+	{
+		Type:             "cup",
+		StartingRules:    []int{62, 81},
+		PermittedLengths: []int{14, 15, 16, 17, 18, 19},
+		Pattern:          regexp.MustCompile(`^(62|81)[0-9]{0,17}$`),
+		SecurityCode:     "CVN",
+	},
+	{
+		Type:             "maestro",
+		StartingRules:    []int{50, 56, 57, 58, 6},
+		PermittedLengths: []int{12, 13, 14, 15, 16, 17, 18, 19},
+		Pattern:          regexp.MustCompile(`^(5[0|6-8][0-9]{0,17}|6[0-9]{0,18})$`),
+		CVCPolicy:        "optional",
+	},
+	{
+		Type:             "elo",
+		StartingRules:    []int{506699, 50670, 50671, 50672, 50673, 50674, 50675, 50676, 506770, 506771, 506772, 506773, 506774, 506775, 506776, 506777, 506778, 401178, 438935, 451416, 457631, 457632, 504175, 627780, 636297, 636368},
+		PermittedLengths: []int{16},
+		Pattern:          regexp.MustCompile(`^((((506699)|(506770)|(506771)|(506772)|(506773)|(506774)|(506775)|(506776)|(506777)|(506778)|(401178)|(438935)|(451416)|(457631)|(457632)|(504175)|(627780)|(636368)|(636297))[0-9]{0,10})|((50676)|(50675)|(50674)|(50673)|(50672)|(50671)|(50670))[0-9]{0,11})$`),
+	},
+	{
+		Type:             "uatp",
+		StartingRules:    []int{1},
+		PermittedLengths: []int{15},
+		Pattern:          regexp.MustCompile(`^1[0-9]{0,14}$`),
+		CVCPolicy:        "optional",
+	},
+	{
+		Type:             "cartebancaire",
+		StartingRules:    []int{4, 5, 6},
+		PermittedLengths: []int{16},
+		Pattern:          regexp.MustCompile(`^[4-6][0-9]{0,15}$`),
+	},
+	{
+		Type:             "visaalphabankbonus",
+		StartingRules:    []int{450903},
+		PermittedLengths: []int{16},
+		Pattern:          regexp.MustCompile(`^(450903)[0-9]{0,10}$`),
+	},
+	{
+		Type:             "mcalphabankbonus",
+		StartingRules:    []int{510099},
+		PermittedLengths: []int{16},
+		Pattern:          regexp.MustCompile(`^(510099)[0-9]{0,10}$`),
+	},
+	{
+		Type:             "hiper",
+		StartingRules:    []int{637095, 637568, 637599, 637609, 637612},
+		PermittedLengths: []int{16},
+		Pattern:          regexp.MustCompile(`^(637095|637568|637599|637609|637612)[0-9]{0,10}$`),
+	},
+	{
+		Type:             "oasis",
+		StartingRules:    []int{982616},
+		PermittedLengths: []int{16},
+		Pattern:          regexp.MustCompile(`^(982616)[0-9]{0,10}$`),
+		CVCPolicy:        "optional",
+	},
+	{
+		Type:             "karenmillen",
+		StartingRules:    []int{98261465},
+		PermittedLengths: []int{16},
+		Pattern:          regexp.MustCompile(`^(98261465)[0-9]{0,8}$`),
+		CVCPolicy:        "optional",
+	},
+	{
+		Type:             "warehouse",
+		StartingRules:    []int{982633},
+		PermittedLengths: []int{16},
+		Pattern:          regexp.MustCompile(`^(982633)[0-9]{0,10}$`),
+		CVCPolicy:        "optional",
+	},
+	{
+		Type:             "mir",
+		StartingRules:    []int{220},
+		PermittedLengths: []int{16, 17, 18, 19},
+		Pattern:          regexp.MustCompile(`^(220)[0-9]{0,16}$`),
+	},
+	{
+		Type:             "codensa",
+		StartingRules:    []int{590712},
+		PermittedLengths: []int{16},
+		Pattern:          regexp.MustCompile(`^(590712)[0-9]{0,10}$`),
+	},
+	{
+		Type:             "naranja",
+		StartingRules:    []int{377798, 377799, 402917, 402918, 527571, 527572, 589562},
+		PermittedLengths: []int{16, 17, 18, 19},
+		Pattern:          regexp.MustCompile(`^(37|40|5[28])([279])\d*$`),
+	},
+	{
+		Type:             "cabal",
+		StartingRules:    []int{589657, 600691, 603522, 6042, 6043, 636908},
+		PermittedLengths: []int{16, 17, 18, 19},
+		Pattern:          regexp.MustCompile(`^(58|6[03])([03469])\d*$`),
+	},
+	{
+		Type:             "shopping",
+		StartingRules:    []int{2799, 589407, 603488},
+		PermittedLengths: []int{16, 17, 18, 19},
+		Pattern:          regexp.MustCompile(`^(27|58|60)([39])\d*$`),
+	},
+	{
+		Type:             "argencard",
+		StartingRules:    []int{501},
+		PermittedLengths: []int{16, 17, 18, 19},
+		Pattern:          regexp.MustCompile(`^(50)(1)\d*$`),
+	},
+	{
+		Type:             "troy",
+		StartingRules:    []int{9792},
+		PermittedLengths: []int{16},
+		Pattern:          regexp.MustCompile(`^(97)(9)\d*$`),
+	},
+	{
+		Type:             "forbrugsforeningen",
+		StartingRules:    []int{600722},
+		PermittedLengths: []int{16},
+		Pattern:          regexp.MustCompile(`^(60)(0)\d*$`),
+	},
+	{
+		Type:             "vpay",
+		StartingRules:    []int{401, 408, 413, 434, 435, 437, 439, 441, 442, 443, 444, 446, 447, 455, 458, 460, 461, 463, 466, 471, 479, 482, 483, 487},
+		PermittedLengths: []int{13, 14, 15, 16, 17, 18, 19},
+		Pattern:          regexp.MustCompile(`^(40[1,8]|413|43[4,5]|44[1,23467]|45[5,8]|46[0,136]|47[1,9]|48[2,37])[0-9]{0,16}$`),
+	},
+	{
+		Type:             "rupay",
+		StartingRules:    []int{100003, 5082, 5085, 5086, 5087, 5088, 5089, 6069, 607, 608, 6521, 6522, 6523, 6524, 6525, 6528, 653003, 653004, 81720, 81721, 81723, 81724, 81725, 81727, 81729, 81733, 81734, 81735, 81736, 81737, 81738, 817406, 817407, 817408, 817409, 817410, 35380, 35381, 35382, 35385, 35386, 35389},
+		PermittedLengths: []int{16},
+		Pattern:          regexp.MustCompile(`^(100003|508(2|[5-9])|60(69|[7-8])|652(1[5-9]|[2-5][0-9]|8[5-9])|65300[3-4]|8172([0-1]|[3-5]|7|9)|817(3[3-8]|40[6-9]|410)|35380([0-2]|[5-6]|9))[0-9]{0,12}$`),
+	},
+}
@@ -0,0 +1,111 @@
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package adyen
+
+// A CVCPolicy describes whether a brand's security code must be given.
+// The values are the same as the ones adyen-web uses.
+type CVCPolicy string
+
+const (
+	// CVCRequired means that the security code must be given.
+	CVCRequired CVCPolicy = "required"
+
+	// CVCOptional means that the security code may be left out.
+	CVCOptional CVCPolicy = "optional"
+
+	// CVCHidden means that the brand has no security code,
+	// so it is never sent.
+	CVCHidden CVCPolicy = "hidden"
+)
+
+const (
+	// ErrSecurityCodeEmpty is used if a required security code is empty.
+	ErrSecurityCodeEmpty ErrorCode = "error.va.sf-cc-cvc.01"
+
+	// ErrSecurityCodeIncomplete is used if the security code does not have
+	// the brand's security code length.
+	ErrSecurityCodeIncomplete ErrorCode = "error.va.sf-cc-cvc.02"
+)
+
+// defaultCVCLength is the security code length of most brands.
+const defaultCVCLength = 3
+
+// cvcLengths holds the security code length of brands that differ
+// from defaultCVCLength.
+var cvcLengths = map[string]int{
+	"amex": 4,
+}
+
+// Policy returns the brand's CVCPolicy.
+func (b *Brand) Policy() CVCPolicy {
+	if b.CVCPolicy == "" {
+		return CVCRequired
+	}
+	return b.CVCPolicy
+}
+
+// CVCLength returns the number of digits in the brand's security code.
+func (b *Brand) CVCLength() int {
+	if n, ok := cvcLengths[b.Type]; ok {
+		return n
+	}
+	return defaultCVCLength
+}
+
+// CVCPolicyFor returns the CVCPolicy of the given brand type.
+// CVCRequired is returned for unknown brands, including NoBrand.
+func CVCPolicyFor(brandType string) CVCPolicy {
+	b, ok := Brands.Find(brandType)
+	if !ok {
+		return CVCRequired
+	}
+	return b.Policy()
+}
+
+// ValidateSecurityCode validates a security code (CVV/CVC) for the given brand type.
+//
+// A security code is always valid for brands with CVCHidden since it is
+// never sent. With CVCOptional, the security code may be empty.
+// Otherwise, it must consist of exactly as many digits as the brand uses.
+//
+// If err != nil, it is a *ValidationError.
+func ValidateSecurityCode(brandType, securityCode string) error {
+	b, ok := Brands.Find(brandType)
+	if !ok {
+		b = Brand{Type: brandType}
+	}
+
+	switch policy := b.Policy(); {
+	case policy == CVCHidden:
+		return nil
+	case securityCode == "" && policy == CVCOptional:
+		return nil
+	case securityCode == "":
+		return &ValidationError{Field: KeySecurityCode, Code: ErrSecurityCodeEmpty}
+	case len(securityCode) != b.CVCLength() || !isDigits(securityCode):
+		return &ValidationError{Field: KeySecurityCode, Code: ErrSecurityCodeIncomplete}
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package adyen
+
+import "testing"
+
+func TestValidateSecurityCode(t *testing.T) {
+	test := func(brand, securityCode string, expectedCode ErrorCode) {
+		if code := errorCode(t, ValidateSecurityCode(brand, securityCode)); code != expectedCode {
+			t.Fatalf("(%s, %s) should have code %q, instead got %q\n", brand, securityCode, expectedCode, code)
+		}
+	}
+
+	test("visa", "737", "")
+	test("visa", "", ErrSecurityCodeEmpty)
+	test("visa", "7373", ErrSecurityCodeIncomplete)
+	test("visa", "7a7", ErrSecurityCodeIncomplete)
+	test("amex", "7373", "")
+	test("amex", "737", ErrSecurityCodeIncomplete)
+	test("maestro", "", "")
+	test("maestro", "73", ErrSecurityCodeIncomplete)
+	test("bcmc", "", "")
+	test("bcmc", "anything", "")
+	test(NoBrand, "", ErrSecurityCodeEmpty)
+}
+
+func TestCVCPolicyFor(t *testing.T) {
+	if CVCPolicyFor("mc") != CVCRequired {
+		t.Fail()
+	}
+
+	if CVCPolicyFor("maestro") != CVCOptional {
+		t.Fail()
+	}
+
+	if CVCPolicyFor("bcmc") != CVCHidden {
+		t.Fail()
+	}
+}
@@ -0,0 +1,167 @@
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package adyen
+
+import (
+	"strconv"
+	"strings"
+)
+
+// An EMV3DSVersion is a version of the EMV 3-D Secure protocol.
+type EMV3DSVersion string
+
+const (
+	// EMV3DSVersion210 is EMV 3-D Secure 2.1.0.
+	EMV3DSVersion210 EMV3DSVersion = "2.1.0"
+
+	// EMV3DSVersion220 is EMV 3-D Secure 2.2.0.
+	EMV3DSVersion220 EMV3DSVersion = "2.2.0"
+)
+
+// AReqBrowser holds the browser fields of an EMV 3DS authentication request (AReq).
+// All values are strings or booleans, as the specification defines them.
+//
+// Read more: https://www.emvco.com/emv-technologies/3-d-secure/
+type AReqBrowser struct {
+	BrowserAcceptHeader string `json:"browserAcceptHeader"`
+	BrowserIP           string `json:"browserIP,omitempty"`
+
+	// BrowserJavaEnabled is left out with 2.2.0 if JavaScript is disabled.
+	BrowserJavaEnabled *bool `json:"browserJavaEnabled,omitempty"`
+
+	// BrowserJavascriptEnabled is only used with 2.2.0.
+	BrowserJavascriptEnabled *bool `json:"browserJavascriptEnabled,omitempty"`
+
+	BrowserLanguage string `json:"browserLanguage,omitempty"`
+
+	// BrowserColorDepth, BrowserScreenHeight, BrowserScreenWidth and BrowserTZ
+	// are left out with 2.2.0 if JavaScript is disabled.
+	BrowserColorDepth   string `json:"browserColorDepth,omitempty"`
+	BrowserScreenHeight string `json:"browserScreenHeight,omitempty"`
+	BrowserScreenWidth  string `json:"browserScreenWidth,omitempty"`
+	BrowserTZ           string `json:"browserTZ,omitempty"`
+
+	BrowserUserAgent string `json:"browserUserAgent"`
+}
+
+// AReqBrowser returns the browser fields of an EMV 3DS authentication request
+// of the given version for b.
+//
+// ColorDepth is encoded as the bit depth itself, like "24" for 24 bits,
+// and browserTZ has the same sign as TimeZoneOffset, like "300" for UTC-5.
+// With 2.2.0, JavaScript is assumed to be enabled if JavaScriptEnabled is nil,
+// and the fields that need JavaScript are left out if it is disabled.
+//
+// If err != nil, it is a *ValidationError with the field of b
+// that cannot be encoded.
+func (b *BrowserInfo) AReqBrowser(version EMV3DSVersion) (*AReqBrowser, error) {
+	javaScript := true
+	switch version {
+	case EMV3DSVersion210:
+	case EMV3DSVersion220:
+		if b.JavaScriptEnabled != nil {
+			javaScript = *b.JavaScriptEnabled
+		}
+	default:
+		return nil, &ValidationError{Field: "messageVersion", Code: ErrFieldInvalid}
+	}
+
+	a := &AReqBrowser{
+		BrowserAcceptHeader: b.AcceptHeader,
+		BrowserIP:           b.IP,
+		BrowserLanguage:     b.Language,
+		BrowserUserAgent:    b.UserAgent,
+	}
+	if version == EMV3DSVersion220 {
+		a.BrowserJavascriptEnabled = Bool(javaScript)
+	}
+	if !javaScript {
+		return a, nil
+	}
+
+	if !contains(ColorDepths, b.ColorDepth) {
+		return nil, &ValidationError{Field: "colorDepth", Code: ErrFieldInvalid}
+	}
+	a.BrowserColorDepth = strconv.Itoa(b.ColorDepth)
+	a.BrowserJavaEnabled = Bool(b.JavaEnabled)
+	a.BrowserScreenHeight = strconv.Itoa(b.ScreenHeight)
+	a.BrowserScreenWidth = strconv.Itoa(b.ScreenWidth)
+	a.BrowserTZ = strconv.Itoa(b.TimeZoneOffset)
+	return a, nil
+}
+
+// BrowserInfo returns the BrowserInfo of the browser fields of a.
+// Fields that a does not have are left empty.
+//
+// If err != nil, it is ValidationErrors with the AReq fields of a
+// that cannot be decoded, like "browserColorDepth".
+func (a *AReqBrowser) BrowserInfo() (*BrowserInfo, error) {
+	b := &BrowserInfo{
+		AcceptHeader: a.BrowserAcceptHeader,
+		IP:           a.BrowserIP,
+		Language:     a.BrowserLanguage,
+		UserAgent:    a.BrowserUserAgent,
+	}
+	if a.BrowserJavaEnabled != nil {
+		b.JavaEnabled = *a.BrowserJavaEnabled
+	}
+	if a.BrowserJavascriptEnabled != nil {
+		b.JavaScriptEnabled = Bool(*a.BrowserJavascriptEnabled)
+	}
+
+	var errs ValidationErrors
+	if a.BrowserColorDepth != "" {
+		depth, err := strconv.Atoi(a.BrowserColorDepth)
+		if err != nil || !contains(ColorDepths, depth) {
+			errs = append(errs, &ValidationError{Field: "browserColorDepth", Code: ErrFieldInvalid})
+		} else {
+			b.ColorDepth = depth
+		}
+	}
+
+	for _, f := range []struct {
+		name  string
+		value string
+		dst   *int
+	}{
+		{"browserScreenHeight", a.BrowserScreenHeight, &b.ScreenHeight},
+		{"browserScreenWidth", a.BrowserScreenWidth, &b.ScreenWidth},
+		{"browserTZ", a.BrowserTZ, &b.TimeZoneOffset},
+	} {
+		if f.value == "" {
+			continue
+		}
+
+		var err error
+		if *f.dst, err = strconv.Atoi(strings.TrimSpace(f.value)); err != nil {
+			errs = append(errs, &ValidationError{Field: f.name, Code: ErrFieldInvalid})
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return b, nil
+}
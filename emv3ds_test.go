@@ -0,0 +1,130 @@
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package adyen
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestBrowserInfo_AReqBrowser(t *testing.T) {
+	info := BrowserInfo{
+		AcceptHeader:   "text/html",
+		ColorDepth:     4,
+		JavaEnabled:    true,
+		Language:       "en-US",
+		ScreenHeight:   1080,
+		ScreenWidth:    1920,
+		TimeZoneOffset: -300,
+		UserAgent:      "Mozilla/5.0",
+		IP:             "192.0.2.1",
+	}
+
+	test := func(info BrowserInfo, version EMV3DSVersion, expected string) {
+		a, err := info.AReqBrowser(version)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		b, err := json.Marshal(a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != expected {
+			t.Fatalf("expected JSON:\n%s\ninstead got:\n%s\n", expected, b)
+		}
+
+		// decoding must give back the original browser info.
+		decoded, err := a.BrowserInfo()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if version == EMV3DSVersion220 && info.JavaScriptEnabled == nil {
+			info.JavaScriptEnabled = Bool(true)
+		}
+		if info.JavaScriptEnabled != nil && !*info.JavaScriptEnabled {
+			info.ColorDepth, info.JavaEnabled, info.ScreenHeight, info.ScreenWidth, info.TimeZoneOffset = 0, false, 0, 0, 0
+		}
+		if ok := (decoded.JavaScriptEnabled == nil) == (info.JavaScriptEnabled == nil) &&
+			(decoded.JavaScriptEnabled == nil || *decoded.JavaScriptEnabled == *info.JavaScriptEnabled); !ok {
+			t.Fatalf("expected JavaScriptEnabled %v, instead got %v\n", info.JavaScriptEnabled, decoded.JavaScriptEnabled)
+		}
+		decoded.JavaScriptEnabled, info.JavaScriptEnabled = nil, nil
+		if *decoded != info {
+			t.Fatalf("expected %+v, instead got %+v\n", info, *decoded)
+		}
+	}
+
+	test(info, EMV3DSVersion210,
+		`{"browserAcceptHeader":"text/html","browserIP":"192.0.2.1","browserJavaEnabled":true,"browserLanguage":"en-US",`+
+			`"browserColorDepth":"4","browserScreenHeight":"1080","browserScreenWidth":"1920","browserTZ":"-300",`+
+			`"browserUserAgent":"Mozilla/5.0"}`)
+	test(info, EMV3DSVersion220,
+		`{"browserAcceptHeader":"text/html","browserIP":"192.0.2.1","browserJavaEnabled":true,"browserJavascriptEnabled":true,`+
+			`"browserLanguage":"en-US","browserColorDepth":"4","browserScreenHeight":"1080","browserScreenWidth":"1920",`+
+			`"browserTZ":"-300","browserUserAgent":"Mozilla/5.0"}`)
+
+	info.JavaScriptEnabled = Bool(false)
+	test(info, EMV3DSVersion220,
+		`{"browserAcceptHeader":"text/html","browserIP":"192.0.2.1","browserJavascriptEnabled":false,`+
+			`"browserLanguage":"en-US","browserUserAgent":"Mozilla/5.0"}`)
+
+	info.JavaScriptEnabled = nil
+	info.ColorDepth = 30
+	if _, err := info.AReqBrowser(EMV3DSVersion220); err == nil {
+		t.Fatal("expected an error for an invalid color depth")
+	}
+	if _, err := info.AReqBrowser("1.0.2"); err == nil {
+		t.Fatal("expected an error for an unknown version")
+	}
+
+	// browserColorDepth is the bit depth itself.
+	for _, depth := range ColorDepths {
+		info.ColorDepth = depth
+		a, err := info.AReqBrowser(EMV3DSVersion220)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if expected := strconv.Itoa(depth); a.BrowserColorDepth != expected {
+			t.Fatalf("%d should be encoded as %s, instead got %s\n", depth, expected, a.BrowserColorDepth)
+		}
+		if b, err := a.BrowserInfo(); err != nil || b.ColorDepth != depth {
+			t.Fatalf("%s should be decoded as %d, instead got %+v (%v)\n", a.BrowserColorDepth, depth, b, err)
+		}
+	}
+}
+
+func TestAReqBrowser_BrowserInfo(t *testing.T) {
+	a := AReqBrowser{BrowserColorDepth: "30", BrowserScreenHeight: "abc", BrowserTZ: "60"}
+
+	_, err := a.BrowserInfo()
+	var errs ValidationErrors
+	if !errors.As(err, &errs) || len(errs) != 2 ||
+		errs[0].Field != "browserColorDepth" || errs[1].Field != "browserScreenHeight" {
+		t.Fatalf("unexpected error %v\n", err)
+	}
+}
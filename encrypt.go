@@ -52,11 +52,32 @@ const (
 
 	// KeySecurityCode is the security code field key.
 	KeySecurityCode = "cvc"
+
+	// KeyHolderName is the cardholder name field key.
+	KeyHolderName = "holderName"
+
+	// KeyBankAccountNumber is the bank account number field key.
+	KeyBankAccountNumber = "bankAccountNumber"
+
+	// KeyBankLocationID is the bank location ID field key,
+	// which is the routing number for US bank accounts.
+	KeyBankLocationID = "bankLocationId"
+
+	// KeyPassword is the card password field key used by Korean cards.
+	KeyPassword = "password"
 )
 
 // Encrypt encrypts a card number, security code (CVV/CVC), expiry month and year
 // into a map and correctly formats all values using FormatCardNumber and FormatMonthYear.
+//
+// An error is returned if the card number cannot be normalized with NormalizeCardNumber.
+// The security code is left out if the card's brand has CVCHidden.
 func (enc *Encrypter) Encrypt(number, securityCode string, month, year int) (string, error) {
+	number, err := NormalizeCardNumber(number)
+	if err != nil {
+		return "", err
+	}
+
 	m, y := FormatMonthYear(month, year)
 	return enc.EncryptFields(map[string]string{
 		KeyNumber:       FormatCardNumber(number),
@@ -72,7 +93,16 @@ func (enc *Encrypter) EncryptField(key, value string) (string, error) {
 }
 
 // EncryptFields encrypts a map.
+//
+// If fields has both KeyNumber and KeySecurityCode and the card's brand
+// has CVCHidden, the security code is left out.
 func (enc *Encrypter) EncryptFields(fields map[string]string) (string, error) {
+	if number, ok := fields[KeyNumber]; ok {
+		if _, ok = fields[KeySecurityCode]; ok && CVCPolicyFor(DetectCardType(number)) == CVCHidden {
+			fields = without(fields, KeySecurityCode)
+		}
+	}
+
 	if _, ok := fields[GenerationTimeKey]; !ok {
 		fields[GenerationTimeKey] = enc.GetGenerationTime().Format(GenerationTimeFormat)
 	}
@@ -118,3 +148,14 @@ func (enc *Encrypter) EncryptPlaintext(plaintext []byte) (string, error) {
 		base64.StdEncoding.EncodeToString(nonceWithCiphertext),
 	), nil
 }
+
+// without returns a copy of fields without key.
+func without(fields map[string]string, key string) map[string]string {
+	m := make(map[string]string, len(fields))
+	for k, v := range fields {
+		if k != key {
+			m[k] = v
+		}
+	}
+	return m
+}
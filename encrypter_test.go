@@ -25,8 +25,13 @@
 package adyen
 
 import (
+	"crypto/aes"
 	"crypto/rand"
 	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"github.com/CrimsonAIO/aesccm"
+	"strings"
 	"testing"
 )
 
@@ -54,3 +59,97 @@ func TestEncrypter(t *testing.T) {
 
 	t.Log("Payload:", payload)
 }
+
+func TestEncrypter_HiddenSecurityCode(t *testing.T) {
+	enc, key := newTestEncrypter(t)
+
+	test := func(number string, expectSecurityCode bool) {
+		payload, err := enc.Encrypt(number, "737", 3, 2030)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, ok := decrypt(t, key, payload)[KeySecurityCode]; ok != expectSecurityCode {
+			t.Fatalf("%s: expected security code to be sent: %t\n", number, expectSecurityCode)
+		}
+	}
+
+	test("4871049999999910", true)
+	test("6703444444444449", false)
+}
+
+// newTestEncrypter returns an Encrypter for a new random key, and the key
+// to decrypt its payloads with.
+func newTestEncrypter(t *testing.T) (*Encrypter, *rsa.PrivateKey) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	enc, err := NewEncrypter("v1", &key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return enc, key
+}
+
+// decrypt opens a payload created by an Encrypter with the public key of key.
+func decrypt(t *testing.T, key *rsa.PrivateKey, payload string) map[string]string {
+	t.Helper()
+
+	parts := strings.Split(payload, "$")
+	if len(parts) != 3 || !strings.HasPrefix(parts[0], "adyenjs_") {
+		t.Fatalf("malformed payload %s", payload)
+	}
+
+	sealedKey, err := base64.StdEncoding.DecodeString(parts[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	nonceWithCiphertext, err := base64.StdEncoding.DecodeString(parts[2])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	aesKey, err := rsa.DecryptPKCS1v15(nil, key, sealedKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, err := aes.NewCipher(aesKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ccm, err := aesccm.NewCCM(block, 12, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	plaintext, err := ccm.Open(nil, nonceWithCiphertext[:12], nonceWithCiphertext[12:], nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var fields map[string]string
+	if err = json.Unmarshal(plaintext, &fields); err != nil {
+		t.Fatal(err)
+	}
+	return fields
+}
+
+// marshalFields marshals v to JSON and returns its fields,
+// which must all be strings.
+func marshalFields(t *testing.T, v any) map[string]string {
+	t.Helper()
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var fields map[string]string
+	if err = json.Unmarshal(b, &fields); err != nil {
+		t.Fatalf("%s: %v", b, err)
+	}
+	return fields
+}
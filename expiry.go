@@ -0,0 +1,172 @@
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package adyen
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// ErrExpiryTooOld is used if the card has expired.
+	ErrExpiryTooOld ErrorCode = "error.va.sf-cc-dat.01"
+
+	// ErrExpiryTooFar is used if the expiry date is too far in the future.
+	ErrExpiryTooFar ErrorCode = "error.va.sf-cc-dat.02"
+
+	// ErrExpiryEmpty is used if the expiry date is empty.
+	ErrExpiryEmpty ErrorCode = "error.va.sf-cc-dat.04"
+
+	// ErrExpiryInvalid is used if the expiry date cannot be parsed
+	// or has an invalid month.
+	ErrExpiryInvalid ErrorCode = "error.va.sf-cc-dat.05"
+)
+
+const (
+	// FieldExpiryDate is the ValidationError field used for errors
+	// about both the expiry month and year.
+	FieldExpiryDate = "expiryDate"
+
+	// MaxExpiryYears is how many years in the future an expiry date can be.
+	MaxExpiryYears = 30
+
+	// expiryTolerance is how long after the end of the expiry month in UTC
+	// a card is still accepted. It allows for shoppers in time zones
+	// behind UTC, where the month has not ended yet.
+	expiryTolerance = 12 * time.Hour
+
+	// expirySeparators are the characters that can separate
+	// the month and year in ParseExpiry.
+	expirySeparators = "/-. "
+)
+
+// ParseExpiry parses an expiry date like "03/30", "0330", "3/2030" or "2030-03".
+//
+// The month and year may be separated by "/", "-", "." or a space, and the year
+// may have two or four digits. A year that comes first must have four digits.
+// Two-digit years are expanded to the year closest to now that ends in them,
+// within 50 years in the past and 49 years in the future.
+//
+// If err != nil, it is a *ValidationError.
+func ParseExpiry(s string, now time.Time) (month time.Month, year int, err error) {
+	invalid := &ValidationError{Field: FieldExpiryDate, Code: ErrExpiryInvalid}
+
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, 0, &ValidationError{Field: FieldExpiryDate, Code: ErrExpiryEmpty}
+	}
+
+	var m, y string
+	if strings.ContainsAny(s, expirySeparators) {
+		parts := strings.FieldsFunc(s, func(r rune) bool {
+			return strings.ContainsRune(expirySeparators, r)
+		})
+		if len(parts) != 2 {
+			return 0, 0, invalid
+		}
+
+		m, y = parts[0], parts[1]
+		if len(m) == 4 {
+			// year first, like "2030-03"
+			m, y = y, m
+		}
+	} else if len(s) > 2 {
+		// no separator, like "0330", "330" or "032030"
+		if len(s) == 5 || len(s) > 6 {
+			return 0, 0, invalid
+		}
+		split := len(s) % 2
+		if split == 0 {
+			split = 2
+		}
+		m, y = s[:split], s[split:]
+	}
+
+	if len(m) < 1 || len(m) > 2 || (len(y) != 2 && len(y) != 4) || !isDigits(m) || !isDigits(y) {
+		return 0, 0, invalid
+	}
+
+	mi, _ := strconv.Atoi(m)
+	if mi < 1 || mi > 12 {
+		return 0, 0, invalid
+	}
+	year, _ = strconv.Atoi(y)
+	if len(y) == 2 {
+		year = expandYear(year, now.Year())
+	}
+	return time.Month(mi), year, nil
+}
+
+// expandYear expands a two-digit year to the closest year to current
+// that ends in the same two digits, preferring the past for ties.
+func expandYear(yy, current int) int {
+	year := current - current%100 + yy
+	switch {
+	case year < current-50:
+		year += 100
+	case year > current+49:
+		year -= 100
+	}
+	return year
+}
+
+// ValidateExpiry validates that a card expiring at the end of the given month
+// and year has not expired at now and does not expire more than MaxExpiryYears
+// after now.
+//
+// A card is accepted until the end of its expiry month in the time zone
+// furthest behind UTC.
+//
+// If err != nil, it is a *ValidationError.
+func ValidateExpiry[T time.Month | int](month T, year int, now time.Time) error {
+	if month < 1 || month > 12 {
+		return &ValidationError{Field: KeyExpiryMonth, Code: ErrExpiryInvalid}
+	}
+
+	// the first instant of the month after the expiry month
+	end := time.Date(year, time.Month(month)+1, 1, 0, 0, 0, 0, time.UTC)
+
+	now = now.UTC()
+	if now.After(end.Add(expiryTolerance)) {
+		return &ValidationError{Field: FieldExpiryDate, Code: ErrExpiryTooOld}
+	}
+	if months := (year-now.Year())*12 + int(month) - int(now.Month()); months > MaxExpiryYears*12 {
+		return &ValidationError{Field: FieldExpiryDate, Code: ErrExpiryTooFar}
+	}
+	return nil
+}
+
+// ParseExpiry is like the package-level ParseExpiry, but expands two-digit
+// years using enc.GetGenerationTime.
+func (enc *Encrypter) ParseExpiry(s string) (time.Month, int, error) {
+	return ParseExpiry(s, enc.GetGenerationTime())
+}
+
+// ValidateExpiry is like the package-level ValidateExpiry, but validates
+// against enc.GetGenerationTime.
+func (enc *Encrypter) ValidateExpiry(month time.Month, year int) error {
+	return ValidateExpiry(month, year, enc.GetGenerationTime())
+}
@@ -0,0 +1,93 @@
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package adyen
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseExpiry(t *testing.T) {
+	now := time.Date(2024, time.June, 15, 12, 0, 0, 0, time.UTC)
+
+	test := func(s string, em time.Month, ey int) {
+		if m, y, err := ParseExpiry(s, now); err != nil || m != em || y != ey {
+			t.Fatalf("%q should be (%d, %d), instead got (%d, %d, %v)\n", s, em, ey, m, y, err)
+		}
+	}
+
+	test("03/30", time.March, 2030)
+	test("0330", time.March, 2030)
+	test("330", time.March, 2030)
+	test("3/2030", time.March, 2030)
+	test("032030", time.March, 2030)
+	test("2030-03", time.March, 2030)
+	test(" 12 / 28 ", time.December, 2028)
+	test("12-99", time.December, 1999)
+	test("01.73", time.January, 2073)
+
+	for _, s := range []string{"", "13/30", "00/30", "3/300", "30303", "03/3a", "2030/3/1"} {
+		if _, _, err := ParseExpiry(s, now); err == nil {
+			t.Fatalf("%q should not parse\n", s)
+		}
+	}
+}
+
+func TestValidateExpiry(t *testing.T) {
+	test := func(m time.Month, y int, now time.Time, expectedCode ErrorCode) {
+		if code := errorCode(t, ValidateExpiry(m, y, now)); code != expectedCode {
+			t.Fatalf("(%d, %d) at %s should have code %q, instead got %q\n", m, y, now, expectedCode, code)
+		}
+	}
+
+	now := time.Date(2024, time.June, 15, 12, 0, 0, 0, time.UTC)
+	test(time.June, 2024, now, "")
+	test(time.May, 2024, now, ErrExpiryTooOld)
+	test(time.June, 2054, now, "")
+	test(time.July, 2054, now, ErrExpiryTooFar)
+	test(13, 2030, now, ErrExpiryInvalid)
+
+	// the card is still valid while it is June somewhere.
+	test(time.June, 2024, time.Date(2024, time.July, 1, 11, 0, 0, 0, time.UTC), "")
+	test(time.June, 2024, time.Date(2024, time.July, 1, 13, 0, 0, 0, time.UTC), ErrExpiryTooOld)
+
+	// the time zone of now does not matter.
+	tz := time.FixedZone("UTC+14", 14*60*60)
+	test(time.June, 2024, time.Date(2024, time.July, 2, 0, 0, 0, 0, tz), "")
+}
+
+func TestEncrypter_ValidateExpiry(t *testing.T) {
+	enc := &Encrypter{GetGenerationTime: func() time.Time {
+		return time.Date(2024, time.June, 15, 12, 0, 0, 0, time.UTC)
+	}}
+
+	m, y, err := enc.ParseExpiry("05/24")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = enc.ValidateExpiry(m, y); err == nil {
+		t.Fatal("expected card to have expired")
+	}
+}
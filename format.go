@@ -31,8 +31,22 @@ import (
 	"time"
 )
 
-// FormatCardNumber formats the given card number into the Adyen format.
-// Numbers less than 15 digits (excluding white space) are ignored.
+// cardNumberGroups holds the digit groups of brands that are not formatted
+// in groups of four. The groups are only used if the card number has at most
+// as many digits as the groups together.
+var cardNumberGroups = map[string][]int{
+	"amex":   {4, 6, 5},
+	"diners": {4, 6, 4},
+}
+
+// FormatCardNumber formats the given card number into the Adyen format
+// by grouping its digits the same way as adyen-web does for its brand.
+// The card number is normalized with NormalizeCardNumber first, and is
+// returned unchanged if that fails.
+//
+// Most brands use groups of four digits, with a shorter last group if needed.
+// American Express uses groups of 4, 6 and 5 digits and Diners Club uses
+// groups of 4, 6 and 4 digits.
 //
 // Examples:
 //
@@ -41,20 +55,48 @@ import (
 // 0123 4567 8901 2345 -> (no change)
 //
 // 0123 456789012345 -> 0123 4567 8901 2345
+//
+// 6250946000000000016 -> 6250 9460 0000 0000 016
+//
+// 378282246310005 -> 3782 822463 10005
 func FormatCardNumber(number string) string {
-	if cnt := strings.Count(number, " "); cnt == 4 {
-		// we assume the number is already formatted if there are exactly 4 spaces.
+	digits, err := NormalizeCardNumber(number)
+	if err != nil {
 		return number
-	} else if cnt > 0 {
-		// else if there was at least 1 space, replace them all.
-		number = strings.ReplaceAll(number, " ", "")
 	}
-	// ignore if the number is less than 15 digits.
-	if len(number) < 15 {
-		return number
+	number = digits
+
+	groups := cardNumberGroups[DetectCardType(number)]
+	if n := sum(groups); len(number) > n {
+		groups = nil
+	}
+
+	var b strings.Builder
+	b.Grow(len(number) + len(number)/4)
+	for len(number) > 0 {
+		size := 4
+		if len(groups) > 0 {
+			size, groups = groups[0], groups[1:]
+		}
+		if size > len(number) {
+			size = len(number)
+		}
+
+		if b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(number[:size])
+		number = number[size:]
 	}
+	return b.String()
+}
 
-	return number[:4] + " " + number[4:8] + " " + number[8:12] + " " + number[12:]
+// sum returns the sum of s.
+func sum(s []int) (n int) {
+	for _, v := range s {
+		n += v
+	}
+	return
 }
 
 // FormatMonthYear formats a card expiry month and year into the Adyen format.
@@ -36,6 +36,79 @@ func TestFormatCardNumber(t *testing.T) {
 	test("0123456789012345", "0123 4567 8901 2345")
 	test("0123 4567 8901 2345", "0123 4567 8901 2345")
 	test("0123 456789012345", "0123 4567 8901 2345")
+	test("012 3456 7890 1234 5", "0123 4567 8901 2345")
+	test("0123", "0123")
+	test("", "")
+}
+
+func TestFormatCardNumber_Brands(t *testing.T) {
+	tests := []struct {
+		brand, number, expected string
+	}{
+		{"mc", "5100000000000000", "5100 0000 0000 0000"},
+		{"visadankort", "4571000000000000", "4571 0000 0000 0000"},
+		{"visa", "4000000000000", "4000 0000 0000 0"},
+		{"visa", "4000180000000002", "4000 1800 0000 0002"},
+		{"visa", "4000000000000000000", "4000 0000 0000 0000 000"},
+		{"amex", "378282246310005", "3782 822463 10005"},
+		{"amex", "3782822", "3782 822"},
+		{"diners", "36006666333344", "3600 666633 3344"},
+		{"maestrouk", "6759000000000000", "6759 0000 0000 0000"},
+		{"solo", "6767000000000000", "6767 0000 0000 0000"},
+		{"laser", "6304000000000000", "6304 0000 0000 0000"},
+		{"discover", "6011000000000000", "6011 0000 0000 0000"},
+		{"jcb", "3528000000000000", "3528 0000 0000 0000"},
+		{"bcmc", "6703000000000000", "6703 0000 0000 0000"},
+		{"bijcard", "5100081000000000", "5100 0810 0000 0000"},
+		{"dankort", "5019000000000000", "5019 0000 0000 0000"},
+		{"hipercard", "6062820000000000", "6062 8200 0000 0000"},
+		{"cup", "62000000000000", "6200 0000 0000 00"},
+		{"cup", "6250946000000000016", "6250 9460 0000 0000 016"},
+		{"maestro", "500000000000", "5000 0000 0000"},
+		{"maestro", "6000000000000000000", "6000 0000 0000 0000 000"},
+		{"elo", "5066990000000000", "5066 9900 0000 0000"},
+		{"uatp", "100000000000000", "1000 0000 0000 000"},
+		{"cartebancaire", "5999999999999999", "5999 9999 9999 9999"},
+		{"visaalphabankbonus", "4509030000000000", "4509 0300 0000 0000"},
+		{"mcalphabankbonus", "5100990000000000", "5100 9900 0000 0000"},
+		{"hiper", "6370950000000000", "6370 9500 0000 0000"},
+		{"oasis", "9826160000000000", "9826 1600 0000 0000"},
+		{"karenmillen", "9826146500000000", "9826 1465 0000 0000"},
+		{"warehouse", "9826330000000000", "9826 3300 0000 0000"},
+		{"mir", "22000000000000000", "2200 0000 0000 0000 0"},
+		{"codensa", "5907120000000000", "5907 1200 0000 0000"},
+		{"naranja", "3777980000000000", "3777 9800 0000 0000"},
+		{"cabal", "5896570000000000", "5896 5700 0000 0000"},
+		{"shopping", "27990000000000000", "2799 0000 0000 0000 0"},
+		{"argencard", "5010000000000000", "5010 0000 0000 0000"},
+		{"troy", "9792000000000000", "9792 0000 0000 0000"},
+		{"forbrugsforeningen", "6007220000000000", "6007 2200 0000 0000"},
+		{"vpay", "4010000000000", "4010 0000 0000 0"},
+		{"rupay", "1000030000000000", "1000 0300 0000 0000"},
+	}
+
+	// unreachable brands are detected as the brand that shadows them.
+	unreachable := make(map[string]bool)
+	for _, brand := range Brands.Unreachable() {
+		unreachable[brand] = true
+	}
+
+	tested := make(map[string]bool)
+	for _, test := range tests {
+		if brand := DetectCardType(test.number); brand != test.brand && !unreachable[test.brand] {
+			t.Fatalf("%s should be %s, instead got %s\n", test.number, test.brand, brand)
+		}
+		if formatted := FormatCardNumber(test.number); formatted != test.expected {
+			t.Fatalf("%s should be %s, instead got %s\n", test.number, test.expected, formatted)
+		}
+		tested[test.brand] = true
+	}
+
+	for _, brand := range Brands {
+		if !tested[brand.Type] {
+			t.Errorf("no test for %s\n", brand.Type)
+		}
+	}
 }
 
 func TestFormatMonthYear(t *testing.T) {
@@ -0,0 +1,178 @@
+//go:build ignore
+
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+// gen_card_types generates card_types_gen.go from the vendored copy of
+// adyen-web's card type definitions.
+//
+// Run it with "go generate" from the repository root.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	input  = "third_party/adyen-web/cardType.ts"
+	output = "card_types_gen.go"
+)
+
+var (
+	// pushPattern matches a single "CardType.cards.push({ ... });" statement.
+	pushPattern = regexp.MustCompile(`(?s)CardType\.cards\.push\(\{(.*?)\}\);`)
+
+	cardTypePattern         = regexp.MustCompile(`cardType:\s*'([^']+)'`)
+	startingRulesPattern    = regexp.MustCompile(`startingRules:\s*\[([^\]]*)\]`)
+	permittedLengthsPattern = regexp.MustCompile(`permittedLengths:\s*\[([^\]]*)\]`)
+	regexPattern            = regexp.MustCompile(`pattern:\s*/((?:\\.|[^/\\\n])+)/[a-z]*`)
+	securityCodePattern     = regexp.MustCompile(`securityCode:\s*'([^']+)'`)
+	cvcPolicyPattern        = regexp.MustCompile(`cvcPolicy:\s*'([^']+)'`)
+)
+
+// card is a single card type definition read from the input file.
+type card struct {
+	cardType         string
+	startingRules    []string
+	permittedLengths []string
+	pattern          string
+	securityCode     string
+	cvcPolicy        string
+}
+
+func main() {
+	src, err := os.ReadFile(input)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	cards, err := parse(string(src))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	b, err := generate(cards)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err = os.WriteFile(output, b, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// parse reads every card type definition from src, in order.
+func parse(src string) ([]card, error) {
+	var cards []card
+	for _, m := range pushPattern.FindAllStringSubmatch(src, -1) {
+		body := m[1]
+
+		var c card
+		if c.cardType = submatch(cardTypePattern, body); c.cardType == "" {
+			return nil, fmt.Errorf("missing cardType in %q", body)
+		}
+		if c.pattern = submatch(regexPattern, body); c.pattern == "" {
+			return nil, fmt.Errorf("%s: missing pattern", c.cardType)
+		}
+		// the pattern has to be valid for the Go regexp package too.
+		if _, err := regexp.Compile(c.pattern); err != nil {
+			return nil, fmt.Errorf("%s: %w", c.cardType, err)
+		}
+
+		var err error
+		if c.startingRules, err = numbers(submatch(startingRulesPattern, body)); err != nil {
+			return nil, fmt.Errorf("%s: startingRules: %w", c.cardType, err)
+		}
+		if c.permittedLengths, err = numbers(submatch(permittedLengthsPattern, body)); err != nil {
+			return nil, fmt.Errorf("%s: permittedLengths: %w", c.cardType, err)
+		}
+
+		c.securityCode = submatch(securityCodePattern, body)
+		c.cvcPolicy = submatch(cvcPolicyPattern, body)
+		cards = append(cards, c)
+	}
+
+	if len(cards) == 0 {
+		return nil, fmt.Errorf("no card types found in %s", input)
+	}
+	return cards, nil
+}
+
+// submatch returns the first submatch of re in s, or an empty string.
+func submatch(re *regexp.Regexp, s string) string {
+	if m := re.FindStringSubmatch(s); m != nil {
+		return m[1]
+	}
+	return ""
+}
+
+// numbers splits a comma-separated list of integers.
+func numbers(list string) ([]string, error) {
+	var s []string
+	for _, field := range strings.Split(list, ",") {
+		if field = strings.TrimSpace(field); field == "" {
+			continue
+		}
+		if _, err := strconv.Atoi(field); err != nil {
+			return nil, err
+		}
+		s = append(s, field)
+	}
+	return s, nil
+}
+
+// generate creates the formatted Go source for cards.
+func generate(cards []card) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gen_card_types.go from " + input + "; DO NOT EDIT.\n\n")
+	buf.WriteString("package adyen\n\n")
+	buf.WriteString("import \"regexp\"\n\n")
+	buf.WriteString("// Brands is the brand table used by DetectCardType, in the same order as adyen-web.\n")
+	buf.WriteString("//\n// It must not be modified.\n")
+	buf.WriteString("var Brands = BrandTable{\n")
+	for _, c := range cards {
+		buf.WriteString("{\n")
+		fmt.Fprintf(&buf, "Type: %q,\n", c.cardType)
+		fmt.Fprintf(&buf, "StartingRules: []int{%s},\n", strings.Join(c.startingRules, ", "))
+		fmt.Fprintf(&buf, "PermittedLengths: []int{%s},\n", strings.Join(c.permittedLengths, ", "))
+		fmt.Fprintf(&buf, "Pattern: regexp.MustCompile(`%s`),\n", c.pattern)
+		if c.securityCode != "" {
+			fmt.Fprintf(&buf, "SecurityCode: %q,\n", c.securityCode)
+		}
+		if c.cvcPolicy != "" {
+			fmt.Fprintf(&buf, "CVCPolicy: %q,\n", c.cvcPolicy)
+		}
+		buf.WriteString("},\n")
+	}
+	buf.WriteString("}\n")
+
+	return format.Source(buf.Bytes())
+}
@@ -0,0 +1,72 @@
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package adyen
+
+import "testing"
+
+func TestValidateGiftCardPIN(t *testing.T) {
+	test := func(brand, pin string, valid bool) {
+		if err := ValidateGiftCardPIN(brand, pin); (err == nil) != valid {
+			t.Fatalf("(%s, %s) should be valid: %t, instead got %v\n", brand, pin, valid, err)
+		}
+	}
+
+	test(GiftCardGivex, "1234", true)
+	test(GiftCardGivex, "123", false)
+	test(GiftCardSVS, "12345678", true)
+	test(GiftCardSVS, "123456789", false)
+	test(GiftCardGeneric, "737", true)
+	test("valuelink", "737", true)
+	test(GiftCardGivex, "", false)
+	test(GiftCardGivex, "12a4", false)
+}
+
+func TestEncrypter_EncryptGiftCard(t *testing.T) {
+	enc, key := newTestEncrypter(t)
+
+	pm, err := enc.EncryptGiftCard(GiftCardGivex, " 6036280000000000000 ", "1234")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if fields := decrypt(t, key, pm.EncryptedCardNumber); fields[KeyNumber] != "6036280000000000000" || len(fields) != 2 {
+		t.Fatalf("unexpected fields %v\n", fields)
+	}
+	if fields := decrypt(t, key, pm.EncryptedSecurityCode); fields[KeySecurityCode] != "1234" || len(fields) != 2 {
+		t.Fatalf("unexpected fields %v\n", fields)
+	}
+
+	m := marshalFields(t, pm)
+	if m["type"] != "giftcard" || m["brand"] != "givex" || m["encryptedCardNumber"] == "" || m["encryptedSecurityCode"] == "" {
+		t.Fatalf("unexpected fields %v\n", m)
+	}
+
+	if _, err = enc.EncryptGiftCard(GiftCardGivex, "6036280000000000000", "12"); err == nil {
+		t.Fatal("expected an error for a short PIN")
+	}
+	if _, err = enc.EncryptGiftCard(GiftCardGivex, " ", "1234"); err == nil {
+		t.Fatal("expected an error for an empty number")
+	}
+}
@@ -0,0 +1,233 @@
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package adyen
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MaxHolderNameLength is the default maximum length of a cardholder name
+// in characters, which is the limit that 3D Secure 2 uses.
+const MaxHolderNameLength = 45
+
+// HolderNameOptions are options for NormalizeHolderName.
+type HolderNameOptions struct {
+	// Transliterate transliterates Latin letters with diacritics and
+	// ligatures to ASCII, like "é" to "e" and "ß" to "ss", as well as
+	// "’" to "'" and decimal digits to "0" to "9". Names with letters
+	// that cannot be transliterated are rejected.
+	Transliterate bool
+
+	// MaxLength is the maximum length of the name in characters.
+	// If zero, MaxHolderNameLength is used.
+	MaxLength int
+
+	// Truncate truncates names that are longer than MaxLength
+	// instead of rejecting them.
+	Truncate bool
+}
+
+// HolderNameChanges reports what NormalizeHolderName changed.
+type HolderNameChanges struct {
+	// Trimmed is set if leading or trailing white space was removed.
+	Trimmed bool
+
+	// Collapsed is set if white space inside the name was replaced
+	// with a single space.
+	Collapsed bool
+
+	// Removed is set if characters that are not allowed in a name, like
+	// control characters and emoji, were removed.
+	Removed bool
+
+	// Transliterated is set if letters were transliterated to ASCII.
+	Transliterated bool
+
+	// Truncated is set if the name was truncated to the maximum length.
+	Truncated bool
+}
+
+// Changed reports whether anything was changed.
+func (c HolderNameChanges) Changed() bool {
+	return c.Trimmed || c.Collapsed || c.Removed || c.Transliterated || c.Truncated
+}
+
+// NormalizeHolderName normalizes a cardholder name so that it can be sent
+// as KeyHolderName.
+//
+// Leading and trailing white space is removed, other white space is replaced
+// with a single space and characters other than letters, combining marks,
+// digits, spaces and the punctuation in "'-.,’" are removed. The name is then
+// transliterated and checked against the length limit as set in opts.
+// A name without any letter, like "--", is not valid.
+//
+// If err != nil, it is a *ValidationError.
+func NormalizeHolderName(name string, opts HolderNameOptions) (normalized string, changes HolderNameChanges, err error) {
+	maxLength := opts.MaxLength
+	if maxLength <= 0 {
+		maxLength = MaxHolderNameLength
+	}
+
+	if trimmed := strings.TrimFunc(name, unicode.IsSpace); trimmed != name {
+		name, changes.Trimmed = trimmed, true
+	}
+
+	var b strings.Builder
+	b.Grow(len(name))
+	space := false
+	for _, r := range name {
+		switch {
+		case unicode.IsSpace(r):
+			if space || r != ' ' {
+				changes.Collapsed = true
+			}
+			space = true
+			continue
+		case !isHolderNameRune(r):
+			changes.Removed = true
+			continue
+		}
+
+		if space && b.Len() > 0 {
+			b.WriteByte(' ')
+		} else if space {
+			// white space next to removed characters at the start.
+			changes.Trimmed = true
+		}
+		space = false
+
+		if !opts.Transliterate || r < utf8.RuneSelf {
+			b.WriteRune(r)
+		} else if unicode.Is(unicode.Mn, r) {
+			// combining marks are dropped along with the diacritics they add.
+			changes.Transliterated = true
+		} else if ascii, ok := transliterations[r]; ok {
+			b.WriteString(ascii)
+			changes.Transliterated = true
+		} else if unicode.IsDigit(r) {
+			b.WriteByte('0' + byte(digitValue(r)))
+			changes.Transliterated = true
+		} else {
+			return "", changes, &ValidationError{Field: KeyHolderName, Code: ErrHolderNameInvalid}
+		}
+	}
+
+	if space && b.Len() > 0 {
+		// white space next to removed characters at the end.
+		changes.Trimmed = true
+	}
+
+	normalized = b.String()
+	if normalized == "" {
+		return "", changes, &ValidationError{Field: KeyHolderName, Code: ErrFieldIncomplete}
+	}
+	if strings.IndexFunc(normalized, unicode.IsLetter) < 0 {
+		return "", changes, &ValidationError{Field: KeyHolderName, Code: ErrHolderNameInvalid}
+	}
+
+	if utf8.RuneCountInString(normalized) > maxLength {
+		if !opts.Truncate {
+			return "", changes, &ValidationError{Field: KeyHolderName, Code: ErrHolderNameInvalid}
+		}
+
+		runes := []rune(normalized)[:maxLength]
+		normalized, changes.Truncated = strings.TrimRightFunc(string(runes), unicode.IsSpace), true
+	}
+	return normalized, changes, nil
+}
+
+// isHolderNameRune reports whether r is allowed in a cardholder name.
+func isHolderNameRune(r rune) bool {
+	switch {
+	case unicode.Is(unicode.Variation_Selector, r):
+		// variation selectors are marks, but only ever change how emoji look.
+		return false
+	case unicode.IsLetter(r), unicode.IsMark(r), unicode.IsDigit(r):
+		return true
+	default:
+		return strings.ContainsRune("'-.,’", r)
+	}
+}
+
+// transliterations holds the ASCII transliteration of Latin letters
+// and of the typographic apostrophe.
+var transliterations = map[rune]string{
+	'’': "'",
+	'À': "A", 'Á': "A", 'Â': "A", 'Ã': "A", 'Ä': "A", 'Å': "A", 'Æ': "AE", 'Ç': "C", 'È': "E",
+	'É': "E", 'Ê': "E", 'Ë': "E", 'Ì': "I", 'Í': "I", 'Î': "I", 'Ï': "I", 'Ð': "D", 'Ñ': "N", 'Ò': "O",
+	'Ó': "O", 'Ô': "O", 'Õ': "O", 'Ö': "O", 'Ø': "O", 'Ù': "U", 'Ú': "U", 'Û': "U", 'Ü': "U", 'Ý': "Y",
+	'Þ': "Th", 'ß': "ss", 'à': "a", 'á': "a", 'â': "a", 'ã': "a", 'ä': "a", 'å': "a", 'æ': "ae",
+	'ç': "c", 'è': "e", 'é': "e", 'ê': "e", 'ë': "e", 'ì': "i", 'í': "i", 'î': "i", 'ï': "i", 'ð': "d",
+	'ñ': "n", 'ò': "o", 'ó': "o", 'ô': "o", 'õ': "o", 'ö': "o", 'ø': "o", 'ù': "u", 'ú': "u", 'û': "u",
+	'ü': "u", 'ý': "y", 'þ': "th", 'ÿ': "y", 'Ā': "A", 'ā': "a", 'Ă': "A", 'ă': "a", 'Ą': "A",
+	'ą': "a", 'Ć': "C", 'ć': "c", 'Ĉ': "C", 'ĉ': "c", 'Ċ': "C", 'ċ': "c", 'Č': "C", 'č': "c", 'Ď': "D",
+	'ď': "d", 'Đ': "D", 'đ': "d", 'Ē': "E", 'ē': "e", 'Ĕ': "E", 'ĕ': "e", 'Ė': "E", 'ė': "e", 'Ę': "E",
+	'ę': "e", 'Ě': "E", 'ě': "e", 'Ĝ': "G", 'ĝ': "g", 'Ğ': "G", 'ğ': "g", 'Ġ': "G", 'ġ': "g", 'Ģ': "G",
+	'ģ': "g", 'Ĥ': "H", 'ĥ': "h", 'Ħ': "H", 'ħ': "h", 'Ĩ': "I", 'ĩ': "i", 'Ī': "I", 'ī': "i", 'Ĭ': "I",
+	'ĭ': "i", 'Į': "I", 'į': "i", 'İ': "I", 'ı': "i", 'Ĳ': "IJ", 'ĳ': "ij", 'Ĵ': "J", 'ĵ': "j",
+	'Ķ': "K", 'ķ': "k", 'ĸ': "k", 'Ĺ': "L", 'ĺ': "l", 'Ļ': "L", 'ļ': "l", 'Ľ': "L", 'ľ': "l", 'Ŀ': "L",
+	'ŀ': "l", 'Ł': "L", 'ł': "l", 'Ń': "N", 'ń': "n", 'Ņ': "N", 'ņ': "n", 'Ň': "N", 'ň': "n",
+	'ŉ': "'n", 'Ŋ': "N", 'ŋ': "n", 'Ō': "O", 'ō': "o", 'Ŏ': "O", 'ŏ': "o", 'Ő': "O", 'ő': "o",
+	'Œ': "OE", 'œ': "oe", 'Ŕ': "R", 'ŕ': "r", 'Ŗ': "R", 'ŗ': "r", 'Ř': "R", 'ř': "r", 'Ś': "S",
+	'ś': "s", 'Ŝ': "S", 'ŝ': "s", 'Ş': "S", 'ş': "s", 'Š': "S", 'š': "s", 'Ţ': "T", 'ţ': "t", 'Ť': "T",
+	'ť': "t", 'Ŧ': "T", 'ŧ': "t", 'Ũ': "U", 'ũ': "u", 'Ū': "U", 'ū': "u", 'Ŭ': "U", 'ŭ': "u", 'Ů': "U",
+	'ů': "u", 'Ű': "U", 'ű': "u", 'Ų': "U", 'ų': "u", 'Ŵ': "W", 'ŵ': "w", 'Ŷ': "Y", 'ŷ': "y", 'Ÿ': "Y",
+	'Ź': "Z", 'ź': "z", 'Ż': "Z", 'ż': "z", 'Ž': "Z", 'ž': "z", 'ſ': "s", 'Ơ': "O", 'ơ': "o", 'Ư': "U",
+	'ư': "u", 'Ǎ': "A", 'ǎ': "a", 'Ǐ': "I", 'ǐ': "i", 'Ǒ': "O", 'ǒ': "o", 'Ǔ': "U", 'ǔ': "u", 'Ǖ': "U",
+	'ǖ': "u", 'Ǘ': "U", 'ǘ': "u", 'Ǚ': "U", 'ǚ': "u", 'Ǜ': "U", 'ǜ': "u", 'Ǟ': "A", 'ǟ': "a", 'Ǡ': "A",
+	'ǡ': "a", 'Ǧ': "G", 'ǧ': "g", 'Ǩ': "K", 'ǩ': "k", 'Ǫ': "O", 'ǫ': "o", 'Ǭ': "O", 'ǭ': "o", 'ǰ': "j",
+	'Ǵ': "G", 'ǵ': "g", 'Ǹ': "N", 'ǹ': "n", 'Ǻ': "A", 'ǻ': "a", 'Ȁ': "A", 'ȁ': "a", 'Ȃ': "A", 'ȃ': "a",
+	'Ȅ': "E", 'ȅ': "e", 'Ȇ': "E", 'ȇ': "e", 'Ȉ': "I", 'ȉ': "i", 'Ȋ': "I", 'ȋ': "i", 'Ȍ': "O", 'ȍ': "o",
+	'Ȏ': "O", 'ȏ': "o", 'Ȑ': "R", 'ȑ': "r", 'Ȓ': "R", 'ȓ': "r", 'Ȕ': "U", 'ȕ': "u", 'Ȗ': "U", 'ȗ': "u",
+	'Ș': "S", 'ș': "s", 'Ț': "T", 'ț': "t", 'Ȟ': "H", 'ȟ': "h", 'Ȧ': "A", 'ȧ': "a", 'Ȩ': "E", 'ȩ': "e",
+	'Ȫ': "O", 'ȫ': "o", 'Ȭ': "O", 'ȭ': "o", 'Ȯ': "O", 'ȯ': "o", 'Ȱ': "O", 'ȱ': "o", 'Ȳ': "Y", 'ȳ': "y",
+	'Ḁ': "A", 'ḁ': "a", 'Ḃ': "B", 'ḃ': "b", 'Ḅ': "B", 'ḅ': "b", 'Ḇ': "B", 'ḇ': "b", 'Ḉ': "C", 'ḉ': "c",
+	'Ḋ': "D", 'ḋ': "d", 'Ḍ': "D", 'ḍ': "d", 'Ḏ': "D", 'ḏ': "d", 'Ḑ': "D", 'ḑ': "d", 'Ḓ': "D", 'ḓ': "d",
+	'Ḕ': "E", 'ḕ': "e", 'Ḗ': "E", 'ḗ': "e", 'Ḙ': "E", 'ḙ': "e", 'Ḛ': "E", 'ḛ': "e", 'Ḝ': "E", 'ḝ': "e",
+	'Ḟ': "F", 'ḟ': "f", 'Ḡ': "G", 'ḡ': "g", 'Ḣ': "H", 'ḣ': "h", 'Ḥ': "H", 'ḥ': "h", 'Ḧ': "H", 'ḧ': "h",
+	'Ḩ': "H", 'ḩ': "h", 'Ḫ': "H", 'ḫ': "h", 'Ḭ': "I", 'ḭ': "i", 'Ḯ': "I", 'ḯ': "i", 'Ḱ': "K", 'ḱ': "k",
+	'Ḳ': "K", 'ḳ': "k", 'Ḵ': "K", 'ḵ': "k", 'Ḷ': "L", 'ḷ': "l", 'Ḹ': "L", 'ḹ': "l", 'Ḻ': "L", 'ḻ': "l",
+	'Ḽ': "L", 'ḽ': "l", 'Ḿ': "M", 'ḿ': "m", 'Ṁ': "M", 'ṁ': "m", 'Ṃ': "M", 'ṃ': "m", 'Ṅ': "N", 'ṅ': "n",
+	'Ṇ': "N", 'ṇ': "n", 'Ṉ': "N", 'ṉ': "n", 'Ṋ': "N", 'ṋ': "n", 'Ṍ': "O", 'ṍ': "o", 'Ṏ': "O", 'ṏ': "o",
+	'Ṑ': "O", 'ṑ': "o", 'Ṓ': "O", 'ṓ': "o", 'Ṕ': "P", 'ṕ': "p", 'Ṗ': "P", 'ṗ': "p", 'Ṙ': "R", 'ṙ': "r",
+	'Ṛ': "R", 'ṛ': "r", 'Ṝ': "R", 'ṝ': "r", 'Ṟ': "R", 'ṟ': "r", 'Ṡ': "S", 'ṡ': "s", 'Ṣ': "S", 'ṣ': "s",
+	'Ṥ': "S", 'ṥ': "s", 'Ṧ': "S", 'ṧ': "s", 'Ṩ': "S", 'ṩ': "s", 'Ṫ': "T", 'ṫ': "t", 'Ṭ': "T", 'ṭ': "t",
+	'Ṯ': "T", 'ṯ': "t", 'Ṱ': "T", 'ṱ': "t", 'Ṳ': "U", 'ṳ': "u", 'Ṵ': "U", 'ṵ': "u", 'Ṷ': "U", 'ṷ': "u",
+	'Ṹ': "U", 'ṹ': "u", 'Ṻ': "U", 'ṻ': "u", 'Ṽ': "V", 'ṽ': "v", 'Ṿ': "V", 'ṿ': "v", 'Ẁ': "W", 'ẁ': "w",
+	'Ẃ': "W", 'ẃ': "w", 'Ẅ': "W", 'ẅ': "w", 'Ẇ': "W", 'ẇ': "w", 'Ẉ': "W", 'ẉ': "w", 'Ẋ': "X", 'ẋ': "x",
+	'Ẍ': "X", 'ẍ': "x", 'Ẏ': "Y", 'ẏ': "y", 'Ẑ': "Z", 'ẑ': "z", 'Ẓ': "Z", 'ẓ': "z", 'Ẕ': "Z", 'ẕ': "z",
+	'ẖ': "h", 'ẗ': "t", 'ẘ': "w", 'ẙ': "y", 'ẞ': "SS", 'Ạ': "A", 'ạ': "a", 'Ả': "A", 'ả': "a",
+	'Ấ': "A", 'ấ': "a", 'Ầ': "A", 'ầ': "a", 'Ẩ': "A", 'ẩ': "a", 'Ẫ': "A", 'ẫ': "a", 'Ậ': "A", 'ậ': "a",
+	'Ắ': "A", 'ắ': "a", 'Ằ': "A", 'ằ': "a", 'Ẳ': "A", 'ẳ': "a", 'Ẵ': "A", 'ẵ': "a", 'Ặ': "A", 'ặ': "a",
+	'Ẹ': "E", 'ẹ': "e", 'Ẻ': "E", 'ẻ': "e", 'Ẽ': "E", 'ẽ': "e", 'Ế': "E", 'ế': "e", 'Ề': "E", 'ề': "e",
+	'Ể': "E", 'ể': "e", 'Ễ': "E", 'ễ': "e", 'Ệ': "E", 'ệ': "e", 'Ỉ': "I", 'ỉ': "i", 'Ị': "I", 'ị': "i",
+	'Ọ': "O", 'ọ': "o", 'Ỏ': "O", 'ỏ': "o", 'Ố': "O", 'ố': "o", 'Ồ': "O", 'ồ': "o", 'Ổ': "O", 'ổ': "o",
+	'Ỗ': "O", 'ỗ': "o", 'Ộ': "O", 'ộ': "o", 'Ớ': "O", 'ớ': "o", 'Ờ': "O", 'ờ': "o", 'Ở': "O", 'ở': "o",
+	'Ỡ': "O", 'ỡ': "o", 'Ợ': "O", 'ợ': "o", 'Ụ': "U", 'ụ': "u", 'Ủ': "U", 'ủ': "u", 'Ứ': "U", 'ứ': "u",
+	'Ừ': "U", 'ừ': "u", 'Ử': "U", 'ử': "u", 'Ữ': "U", 'ữ': "u", 'Ự': "U", 'ự': "u", 'Ỳ': "Y", 'ỳ': "y",
+	'Ỵ': "Y", 'ỵ': "y", 'Ỷ': "Y", 'ỷ': "y", 'Ỹ': "Y", 'ỹ': "y",
+}
@@ -0,0 +1,75 @@
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package adyen
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNormalizeHolderName(t *testing.T) {
+	test := func(name string, opts HolderNameOptions, expected string, expectedChanges HolderNameChanges) {
+		normalized, changes, err := NormalizeHolderName(name, opts)
+		if err != nil {
+			t.Fatalf("%q: unexpected error %v\n", name, err)
+		}
+		if normalized != expected || changes != expectedChanges {
+			t.Fatalf("%q should be (%q, %+v), instead got (%q, %+v)\n", name, expected, expectedChanges, normalized, changes)
+		}
+	}
+
+	test("John Smith", HolderNameOptions{}, "John Smith", HolderNameChanges{})
+	test("  John Smith\n", HolderNameOptions{}, "John Smith", HolderNameChanges{Trimmed: true})
+	test("John \t Smith", HolderNameOptions{}, "John Smith", HolderNameChanges{Collapsed: true})
+	test("John Smith", HolderNameOptions{}, "John Smith", HolderNameChanges{Collapsed: true})
+	test("John 😀 Smith", HolderNameOptions{}, "John Smith", HolderNameChanges{Collapsed: true, Removed: true})
+	test("J\x00ohn ❤️ O’Brien-Smith", HolderNameOptions{}, "John O’Brien-Smith", HolderNameChanges{Collapsed: true, Removed: true})
+	test("😀 John", HolderNameOptions{}, "John", HolderNameChanges{Trimmed: true, Removed: true})
+	test("John 😀", HolderNameOptions{}, "John", HolderNameChanges{Trimmed: true, Removed: true})
+	test("😀John", HolderNameOptions{}, "John", HolderNameChanges{Removed: true})
+	test(" 😀 John 😀 ", HolderNameOptions{}, "John", HolderNameChanges{Trimmed: true, Removed: true})
+	test("José Müller", HolderNameOptions{}, "José Müller", HolderNameChanges{})
+	test("José Müller", HolderNameOptions{Transliterate: true}, "Jose Muller", HolderNameChanges{Transliterated: true})
+	test("José Straße", HolderNameOptions{Transliterate: true}, "Jose Strasse", HolderNameChanges{Transliterated: true})
+	test("Conan O’Brien", HolderNameOptions{Transliterate: true}, "Conan O'Brien", HolderNameChanges{Transliterated: true})
+	test("John Smith ٣", HolderNameOptions{Transliterate: true}, "John Smith 3", HolderNameChanges{Transliterated: true})
+	test("Иван Петров", HolderNameOptions{}, "Иван Петров", HolderNameChanges{})
+	test("John Smith", HolderNameOptions{MaxLength: 5, Truncate: true}, "John", HolderNameChanges{Truncated: true})
+
+	testError := func(name string, opts HolderNameOptions, expectedCode ErrorCode) {
+		_, _, err := NormalizeHolderName(name, opts)
+		if code := errorCode(t, err); code != expectedCode {
+			t.Fatalf("%q should have code %q, instead got %q\n", name, expectedCode, code)
+		}
+	}
+
+	testError("", HolderNameOptions{}, ErrFieldIncomplete)
+	testError(" 😀 ", HolderNameOptions{}, ErrFieldIncomplete)
+	testError("'", HolderNameOptions{}, ErrHolderNameInvalid)
+	testError("--", HolderNameOptions{}, ErrHolderNameInvalid)
+	testError(" . , ", HolderNameOptions{}, ErrHolderNameInvalid)
+	testError(strings.Repeat("a", MaxHolderNameLength+1), HolderNameOptions{}, ErrHolderNameInvalid)
+	testError("Иван Петров", HolderNameOptions{Transliterate: true}, ErrHolderNameInvalid)
+}
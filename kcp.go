@@ -0,0 +1,157 @@
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package adyen
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// ErrKCPPasswordInvalid is used if the card password digits are not valid.
+	ErrKCPPasswordInvalid ErrorCode = "creditCard.encryptedPassword.invalid"
+
+	// ErrTaxNumberInvalid is used if the tax number is neither a valid birth date
+	// nor a valid business registration number.
+	ErrTaxNumberInvalid ErrorCode = "creditCard.taxNumber.invalid"
+)
+
+const (
+	// FieldTaxNumber is the ValidationError field used for tax number errors.
+	FieldTaxNumber = "taxNumber"
+
+	// kcpPasswordLength is the number of card password digits that are sent.
+	kcpPasswordLength = 2
+
+	// birthDateLength and businessRegistrationNumberLength are the lengths
+	// of the two kinds of tax number.
+	birthDateLength                  = 6
+	businessRegistrationNumberLength = 10
+)
+
+// KCPFields are the extra fields of a Korean card payment processed through KCP.
+// They are sent in the "paymentMethod" object next to the encrypted card fields.
+type KCPFields struct {
+	// EncryptedPassword is the encrypted first two digits of the card password.
+	EncryptedPassword string `json:"encryptedPassword"`
+
+	// TaxNumber is the cardholder's birth date (YYMMDD) for personal cards or
+	// the 10-digit business registration number for corporate cards.
+	TaxNumber string `json:"taxNumber"`
+}
+
+// ValidateKCPPassword validates that password is the first two digits
+// of a Korean card's password.
+//
+// If err != nil, it is a *ValidationError.
+func ValidateKCPPassword(password string) error {
+	switch {
+	case password == "":
+		return &ValidationError{Field: KeyPassword, Code: ErrFieldIncomplete}
+	case len(password) != kcpPasswordLength || !isDigits(password):
+		return &ValidationError{Field: KeyPassword, Code: ErrKCPPasswordInvalid}
+	}
+	return nil
+}
+
+// ValidateTaxNumber validates a Korean tax number, which is either the
+// cardholder's birth date as YYMMDD or a 10-digit business registration
+// number with a valid check digit.
+//
+// If err != nil, it is a *ValidationError.
+func ValidateTaxNumber(taxNumber string) error {
+	var valid bool
+	switch {
+	case taxNumber == "":
+		return &ValidationError{Field: FieldTaxNumber, Code: ErrFieldIncomplete}
+	case !isDigits(taxNumber):
+	case len(taxNumber) == birthDateLength:
+		valid = birthDateValid(taxNumber)
+	case len(taxNumber) == businessRegistrationNumberLength:
+		valid = businessRegistrationNumberValid(taxNumber)
+	}
+
+	if !valid {
+		return &ValidationError{Field: FieldTaxNumber, Code: ErrTaxNumberInvalid}
+	}
+	return nil
+}
+
+// birthDateValid reports whether the YYMMDD date exists in either the
+// 1900s or the 2000s, so that February 29 is allowed in leap years.
+func birthDateValid(date string) bool {
+	yy, _ := strconv.Atoi(date[:2])
+	mm, _ := strconv.Atoi(date[2:4])
+	dd, _ := strconv.Atoi(date[4:])
+
+	for _, year := range [...]int{1900 + yy, 2000 + yy} {
+		t := time.Date(year, time.Month(mm), dd, 0, 0, 0, 0, time.UTC)
+		if t.Year() == year && t.Month() == time.Month(mm) && t.Day() == dd {
+			return true
+		}
+	}
+	return false
+}
+
+// businessRegistrationNumberValid reports whether the check digit of a
+// 10-digit Korean business registration number is valid.
+func businessRegistrationNumberValid(number string) bool {
+	weights := [...]int{1, 3, 7, 1, 3, 7, 1, 3, 5}
+
+	sum := 0
+	for i, w := range weights {
+		sum += int(number[i]-'0') * w
+	}
+	// the ninth digit also adds the tens of its weighted value.
+	sum += int(number[8]-'0') * 5 / 10
+
+	return (10-sum%10)%10 == int(number[9]-'0')
+}
+
+// EncryptKCP validates and encrypts the fields of a Korean card payment
+// processed through KCP. Dashes and white space are removed from the
+// tax number, so business registration numbers like "123-45-67890" are accepted.
+func (enc *Encrypter) EncryptKCP(password, taxNumber string) (*KCPFields, error) {
+	taxNumber = strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, taxNumber)
+
+	if err := ValidateKCPPassword(password); err != nil {
+		return nil, err
+	}
+	if err := ValidateTaxNumber(taxNumber); err != nil {
+		return nil, err
+	}
+
+	encryptedPassword, err := enc.EncryptFields(map[string]string{KeyPassword: password})
+	if err != nil {
+		return nil, err
+	}
+	return &KCPFields{EncryptedPassword: encryptedPassword, TaxNumber: taxNumber}, nil
+}
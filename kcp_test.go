@@ -0,0 +1,97 @@
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package adyen
+
+import "testing"
+
+func TestValidateKCPPassword(t *testing.T) {
+	test := func(password string, expectedCode ErrorCode) {
+		if code := errorCode(t, ValidateKCPPassword(password)); code != expectedCode {
+			t.Fatalf("%s should have code %q, instead got %q\n", password, expectedCode, code)
+		}
+	}
+
+	test("12", "")
+	test("00", "")
+	test("1", ErrKCPPasswordInvalid)
+	test("123", ErrKCPPasswordInvalid)
+	test("1a", ErrKCPPasswordInvalid)
+	test("", ErrFieldIncomplete)
+}
+
+func TestValidateTaxNumber(t *testing.T) {
+	test := func(taxNumber string, expectedCode ErrorCode) {
+		if code := errorCode(t, ValidateTaxNumber(taxNumber)); code != expectedCode {
+			t.Fatalf("%s should have code %q, instead got %q\n", taxNumber, expectedCode, code)
+		}
+	}
+
+	// birth dates
+	test("900101", "")
+	test("001231", "")
+	test("000229", "")
+	test("960229", "")
+	test("970229", ErrTaxNumberInvalid)
+	test("901301", ErrTaxNumberInvalid)
+	test("900431", ErrTaxNumberInvalid)
+	test("900100", ErrTaxNumberInvalid)
+
+	// business registration numbers
+	test("2208162517", "")
+	test("2208162518", ErrTaxNumberInvalid)
+
+	test("12345", ErrTaxNumberInvalid)
+	test("1234567", ErrTaxNumberInvalid)
+	test("90010a", ErrTaxNumberInvalid)
+	test("", ErrFieldIncomplete)
+}
+
+func TestEncrypter_EncryptKCP(t *testing.T) {
+	enc, key := newTestEncrypter(t)
+
+	kcp, err := enc.EncryptKCP("12", "220-81-62517")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if fields := decrypt(t, key, kcp.EncryptedPassword); fields[KeyPassword] != "12" {
+		t.Fatalf("unexpected fields %v\n", fields)
+	}
+	if kcp.TaxNumber != "2208162517" {
+		t.Fatalf("unexpected tax number %q\n", kcp.TaxNumber)
+	}
+
+	m := marshalFields(t, kcp)
+	if m["taxNumber"] != "2208162517" || m["encryptedPassword"] == "" {
+		t.Fatalf("unexpected fields %v\n", m)
+	}
+
+	if _, err = enc.EncryptKCP("1", "900101"); err == nil {
+		t.Fatal("expected an error for an invalid password")
+	}
+	if _, err = enc.EncryptKCP("12", "901301"); err == nil {
+		t.Fatal("expected an error for an invalid birth date")
+	}
+}
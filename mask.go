@@ -0,0 +1,117 @@
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package adyen
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// MaskCardNumber masks a card number like PCI DSS allows, keeping only the
+// first digits and the last four digits. The card number is normalized with
+// NormalizeCardNumber first.
+//
+// The first 8 digits are kept for card numbers with at least 16 digits and
+// the first 6 digits otherwise, in line with the PCI DSS guidance for 8-digit
+// BINs. If that would leave no digits to mask, everything but the last
+// four digits is masked, and card numbers with at most four digits are
+// masked completely.
+//
+// Examples:
+//
+// 5123459046058920 -> 51234590****8920
+//
+// 378282246310005 -> 378282*****0005
+func MaskCardNumber(number string) (string, error) {
+	number, err := NormalizeCardNumber(number)
+	if err != nil {
+		return "", err
+	}
+
+	if len(number) <= 4 {
+		return strings.Repeat("*", len(number)), nil
+	}
+
+	first, last := binLength(number), len(number)-4
+	if last-first < 1 {
+		first = 0
+	}
+	return number[:first] + strings.Repeat("*", last-first) + number[last:], nil
+}
+
+// BIN returns the BIN (bank identification number) of a card number,
+// which is its first 8 digits if it has at least 16 digits and its first
+// 6 digits otherwise. The card number is normalized with NormalizeCardNumber first.
+func BIN(number string) (string, error) {
+	number, err := NormalizeCardNumber(number)
+	if err != nil {
+		return "", err
+	}
+	if len(number) < 6 {
+		return "", &ValidationError{Field: KeyNumber, Code: ErrCardNumberIncomplete}
+	}
+	return number[:binLength(number)], nil
+}
+
+// LastFour returns the last four digits of a card number.
+// The card number is normalized with NormalizeCardNumber first.
+func LastFour(number string) (string, error) {
+	number, err := NormalizeCardNumber(number)
+	if err != nil {
+		return "", err
+	}
+	if len(number) < 4 {
+		return "", &ValidationError{Field: KeyNumber, Code: ErrCardNumberIncomplete}
+	}
+	return number[len(number)-4:], nil
+}
+
+// binLength returns the length of the BIN of the normalized card number.
+func binLength(number string) int {
+	if len(number) >= 16 {
+		return 8
+	}
+	return 6
+}
+
+// Fingerprint returns a hex-encoded HMAC-SHA256 of a card number using key.
+// The card number is normalized with NormalizeCardNumber first, so different
+// spellings of the same card number have the same fingerprint.
+//
+// Fingerprints can be stored to recognize a card again without storing its
+// number. The key must be kept secret, since card numbers have few enough
+// possible values that an unkeyed hash can be reversed.
+func Fingerprint(key []byte, number string) (string, error) {
+	number, err := NormalizeCardNumber(number)
+	if err != nil {
+		return "", err
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(number))
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
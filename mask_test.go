@@ -0,0 +1,90 @@
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package adyen
+
+import "testing"
+
+func TestMaskCardNumber(t *testing.T) {
+	test := func(number, expected string) {
+		if masked, err := MaskCardNumber(number); err != nil || masked != expected {
+			t.Fatalf("%s should be %s, instead got %s (%v)\n", number, expected, masked, err)
+		}
+	}
+
+	test("5123459046058920", "51234590****8920")
+	test("5123 4590 4605 8920", "51234590****8920")
+	test("378282246310005", "378282*****0005")
+	test("6250946000000000016", "62509460*******0016")
+	test("4000000000", "******0000")
+	test("400", "***")
+
+	if _, err := MaskCardNumber("5123x"); err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestBIN(t *testing.T) {
+	test := func(number, expectedBIN, expectedLastFour string) {
+		if bin, err := BIN(number); err != nil || bin != expectedBIN {
+			t.Fatalf("%s should have BIN %s, instead got %s (%v)\n", number, expectedBIN, bin, err)
+		}
+		if lastFour, err := LastFour(number); err != nil || lastFour != expectedLastFour {
+			t.Fatalf("%s should end in %s, instead got %s (%v)\n", number, expectedLastFour, lastFour, err)
+		}
+	}
+
+	test("5123459046058920", "51234590", "8920")
+	test("378282246310005", "378282", "0005")
+
+	if _, err := BIN("51234"); err == nil {
+		t.Fatal("expected an error")
+	}
+	if _, err := LastFour("512"); err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	key := []byte("secret")
+
+	a, err := Fingerprint(key, "5123459046058920")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := Fingerprint(key, "5123-4590-4605-8920")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a != b {
+		t.Fatalf("%s and %s should be equal\n", a, b)
+	}
+
+	if c, _ := Fingerprint([]byte("other"), "5123459046058920"); c == a {
+		t.Fatal("fingerprints with different keys should differ")
+	}
+	if c, _ := Fingerprint(key, "4000180000000002"); c == a {
+		t.Fatal("fingerprints of different cards should differ")
+	}
+}
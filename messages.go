@@ -0,0 +1,325 @@
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package adyen
+
+// messages holds the shopper-facing message of each ErrorCode by language.
+// The English messages are the same as adyen-web's.
+var messages = map[string]map[ErrorCode]string{
+	"en": {
+		ErrCardNumberInvalid:        "Enter a valid card number",
+		ErrCardNumberEmpty:          "Enter the card number",
+		ErrCardNumberUnsupported:    "Enter a supported card brand",
+		ErrCardNumberIncomplete:     "Enter the complete card number",
+		ErrSecurityCodeEmpty:        "Enter the security code",
+		ErrSecurityCodeIncomplete:   "Enter the complete security code",
+		ErrExpiryTooOld:             "Card too old",
+		ErrExpiryTooFar:             "Date too far in the future",
+		ErrExpiryEmpty:              "Enter the expiry date",
+		ErrExpiryInvalid:            "Enter the complete expiry date",
+		ErrHolderNameInvalid:        "Enter a valid cardholder name",
+		ErrFieldIncomplete:          "Incomplete field",
+		ErrFieldInvalid:             "Field not valid",
+		ErrBankAccountNumberInvalid: "Enter a valid account number",
+		ErrBankLocationIDInvalid:    "Enter a valid ABA routing number",
+		ErrKCPPasswordInvalid:       "Invalid password",
+		ErrTaxNumberInvalid:         "Invalid cardholder birth date or corporate registration number",
+	},
+	"nl": {
+		ErrCardNumberInvalid:        "Voer een geldig kaartnummer in",
+		ErrCardNumberEmpty:          "Voer het kaartnummer in",
+		ErrCardNumberUnsupported:    "Voer een ondersteund kaartmerk in",
+		ErrCardNumberIncomplete:     "Voer het volledige kaartnummer in",
+		ErrSecurityCodeEmpty:        "Voer de beveiligingscode in",
+		ErrSecurityCodeIncomplete:   "Voer de volledige beveiligingscode in",
+		ErrExpiryTooOld:             "Kaart te oud",
+		ErrExpiryTooFar:             "Datum te ver in de toekomst",
+		ErrExpiryEmpty:              "Voer de vervaldatum in",
+		ErrExpiryInvalid:            "Voer de volledige vervaldatum in",
+		ErrHolderNameInvalid:        "Voer een geldige naam van de kaarthouder in",
+		ErrFieldIncomplete:          "Onvolledig veld",
+		ErrFieldInvalid:             "Veld niet geldig",
+		ErrBankAccountNumberInvalid: "Voer een geldig rekeningnummer in",
+		ErrBankLocationIDInvalid:    "Voer een geldig ABA-routingnummer in",
+		ErrKCPPasswordInvalid:       "Ongeldig wachtwoord",
+		ErrTaxNumberInvalid:         "Ongeldige geboortedatum van de kaarthouder of ongeldig bedrijfsregistratienummer",
+	},
+	"de": {
+		ErrCardNumberInvalid:        "Geben Sie eine gültige Kartennummer ein",
+		ErrCardNumberEmpty:          "Geben Sie die Kartennummer ein",
+		ErrCardNumberUnsupported:    "Geben Sie eine unterstützte Kartenmarke ein",
+		ErrCardNumberIncomplete:     "Geben Sie die vollständige Kartennummer ein",
+		ErrSecurityCodeEmpty:        "Geben Sie den Sicherheitscode ein",
+		ErrSecurityCodeIncomplete:   "Geben Sie den vollständigen Sicherheitscode ein",
+		ErrExpiryTooOld:             "Karte zu alt",
+		ErrExpiryTooFar:             "Datum liegt zu weit in der Zukunft",
+		ErrExpiryEmpty:              "Geben Sie das Ablaufdatum ein",
+		ErrExpiryInvalid:            "Geben Sie das vollständige Ablaufdatum ein",
+		ErrHolderNameInvalid:        "Geben Sie einen gültigen Karteninhabernamen ein",
+		ErrFieldIncomplete:          "Unvollständiges Feld",
+		ErrFieldInvalid:             "Feld ungültig",
+		ErrBankAccountNumberInvalid: "Geben Sie eine gültige Kontonummer ein",
+		ErrBankLocationIDInvalid:    "Geben Sie eine gültige ABA-Routingnummer ein",
+		ErrKCPPasswordInvalid:       "Ungültiges Passwort",
+		ErrTaxNumberInvalid:         "Ungültiges Geburtsdatum des Karteninhabers oder ungültige Handelsregisternummer",
+	},
+	"fr": {
+		ErrCardNumberInvalid:        "Saisissez un numéro de carte valide",
+		ErrCardNumberEmpty:          "Saisissez le numéro de carte",
+		ErrCardNumberUnsupported:    "Saisissez une marque de carte prise en charge",
+		ErrCardNumberIncomplete:     "Saisissez le numéro de carte complet",
+		ErrSecurityCodeEmpty:        "Saisissez le code de sécurité",
+		ErrSecurityCodeIncomplete:   "Saisissez le code de sécurité complet",
+		ErrExpiryTooOld:             "Carte trop ancienne",
+		ErrExpiryTooFar:             "Date trop éloignée dans le futur",
+		ErrExpiryEmpty:              "Saisissez la date d'expiration",
+		ErrExpiryInvalid:            "Saisissez la date d'expiration complète",
+		ErrHolderNameInvalid:        "Saisissez un nom de titulaire de carte valide",
+		ErrFieldIncomplete:          "Champ incomplet",
+		ErrFieldInvalid:             "Champ non valide",
+		ErrBankAccountNumberInvalid: "Saisissez un numéro de compte valide",
+		ErrBankLocationIDInvalid:    "Saisissez un numéro de routage ABA valide",
+		ErrKCPPasswordInvalid:       "Mot de passe incorrect",
+		ErrTaxNumberInvalid:         "Date de naissance du titulaire ou numéro d'immatriculation de l'entreprise incorrect",
+	},
+	"es": {
+		ErrCardNumberInvalid:        "Introduce un número de tarjeta válido",
+		ErrCardNumberEmpty:          "Introduce el número de tarjeta",
+		ErrCardNumberUnsupported:    "Introduce una marca de tarjeta admitida",
+		ErrCardNumberIncomplete:     "Introduce el número de tarjeta completo",
+		ErrSecurityCodeEmpty:        "Introduce el código de seguridad",
+		ErrSecurityCodeIncomplete:   "Introduce el código de seguridad completo",
+		ErrExpiryTooOld:             "Tarjeta demasiado antigua",
+		ErrExpiryTooFar:             "Fecha demasiado lejana en el futuro",
+		ErrExpiryEmpty:              "Introduce la fecha de caducidad",
+		ErrExpiryInvalid:            "Introduce la fecha de caducidad completa",
+		ErrHolderNameInvalid:        "Introduce un nombre del titular de la tarjeta válido",
+		ErrFieldIncomplete:          "Campo incompleto",
+		ErrFieldInvalid:             "Campo no válido",
+		ErrBankAccountNumberInvalid: "Introduce un número de cuenta válido",
+		ErrBankLocationIDInvalid:    "Introduce un número de ruta ABA válido",
+		ErrKCPPasswordInvalid:       "Contraseña no válida",
+		ErrTaxNumberInvalid:         "Fecha de nacimiento del titular o número de registro de empresa no válido",
+	},
+	"it": {
+		ErrCardNumberInvalid:        "Inserisci un numero di carta valido",
+		ErrCardNumberEmpty:          "Inserisci il numero della carta",
+		ErrCardNumberUnsupported:    "Inserisci un circuito di carta supportato",
+		ErrCardNumberIncomplete:     "Inserisci il numero di carta completo",
+		ErrSecurityCodeEmpty:        "Inserisci il codice di sicurezza",
+		ErrSecurityCodeIncomplete:   "Inserisci il codice di sicurezza completo",
+		ErrExpiryTooOld:             "Carta troppo vecchia",
+		ErrExpiryTooFar:             "Data troppo lontana nel futuro",
+		ErrExpiryEmpty:              "Inserisci la data di scadenza",
+		ErrExpiryInvalid:            "Inserisci la data di scadenza completa",
+		ErrHolderNameInvalid:        "Inserisci un nome del titolare della carta valido",
+		ErrFieldIncomplete:          "Campo incompleto",
+		ErrFieldInvalid:             "Campo non valido",
+		ErrBankAccountNumberInvalid: "Inserisci un numero di conto valido",
+		ErrBankLocationIDInvalid:    "Inserisci un numero di routing ABA valido",
+		ErrKCPPasswordInvalid:       "Password non valida",
+		ErrTaxNumberInvalid:         "Data di nascita del titolare o numero di registrazione aziendale non valido",
+	},
+	"pt": {
+		ErrCardNumberInvalid:        "Insira um número de cartão válido",
+		ErrCardNumberEmpty:          "Insira o número do cartão",
+		ErrCardNumberUnsupported:    "Insira uma bandeira de cartão aceita",
+		ErrCardNumberIncomplete:     "Insira o número do cartão completo",
+		ErrSecurityCodeEmpty:        "Insira o código de segurança",
+		ErrSecurityCodeIncomplete:   "Insira o código de segurança completo",
+		ErrExpiryTooOld:             "Cartão muito antigo",
+		ErrExpiryTooFar:             "Data muito distante no futuro",
+		ErrExpiryEmpty:              "Insira a data de validade",
+		ErrExpiryInvalid:            "Insira a data de validade completa",
+		ErrHolderNameInvalid:        "Insira um nome do titular do cartão válido",
+		ErrFieldIncomplete:          "Campo incompleto",
+		ErrFieldInvalid:             "Campo inválido",
+		ErrBankAccountNumberInvalid: "Insira um número de conta válido",
+		ErrBankLocationIDInvalid:    "Insira um número de roteamento ABA válido",
+		ErrKCPPasswordInvalid:       "Senha inválida",
+		ErrTaxNumberInvalid:         "Data de nascimento do titular ou número de registro da empresa inválido",
+	},
+	"pl": {
+		ErrCardNumberInvalid:        "Wprowadź prawidłowy numer karty",
+		ErrCardNumberEmpty:          "Wprowadź numer karty",
+		ErrCardNumberUnsupported:    "Wprowadź obsługiwaną markę karty",
+		ErrCardNumberIncomplete:     "Wprowadź pełny numer karty",
+		ErrSecurityCodeEmpty:        "Wprowadź kod zabezpieczający",
+		ErrSecurityCodeIncomplete:   "Wprowadź pełny kod zabezpieczający",
+		ErrExpiryTooOld:             "Karta jest zbyt stara",
+		ErrExpiryTooFar:             "Data jest zbyt odległa w przyszłości",
+		ErrExpiryEmpty:              "Wprowadź datę ważności",
+		ErrExpiryInvalid:            "Wprowadź pełną datę ważności",
+		ErrHolderNameInvalid:        "Wprowadź prawidłowe imię i nazwisko posiadacza karty",
+		ErrFieldIncomplete:          "Niekompletne pole",
+		ErrFieldInvalid:             "Pole jest nieprawidłowe",
+		ErrBankAccountNumberInvalid: "Wprowadź prawidłowy numer konta",
+		ErrBankLocationIDInvalid:    "Wprowadź prawidłowy numer rozliczeniowy ABA",
+		ErrKCPPasswordInvalid:       "Nieprawidłowe hasło",
+		ErrTaxNumberInvalid:         "Nieprawidłowa data urodzenia posiadacza karty lub numer rejestracyjny firmy",
+	},
+	"sv": {
+		ErrCardNumberInvalid:        "Ange ett giltigt kortnummer",
+		ErrCardNumberEmpty:          "Ange kortnumret",
+		ErrCardNumberUnsupported:    "Ange ett kortmärke som stöds",
+		ErrCardNumberIncomplete:     "Ange hela kortnumret",
+		ErrSecurityCodeEmpty:        "Ange säkerhetskoden",
+		ErrSecurityCodeIncomplete:   "Ange hela säkerhetskoden",
+		ErrExpiryTooOld:             "Kortet är för gammalt",
+		ErrExpiryTooFar:             "Datumet ligger för långt fram i tiden",
+		ErrExpiryEmpty:              "Ange utgångsdatumet",
+		ErrExpiryInvalid:            "Ange hela utgångsdatumet",
+		ErrHolderNameInvalid:        "Ange ett giltigt namn på kortinnehavaren",
+		ErrFieldIncomplete:          "Ofullständigt fält",
+		ErrFieldInvalid:             "Fältet är ogiltigt",
+		ErrBankAccountNumberInvalid: "Ange ett giltigt kontonummer",
+		ErrBankLocationIDInvalid:    "Ange ett giltigt ABA-routingnummer",
+		ErrKCPPasswordInvalid:       "Ogiltigt lösenord",
+		ErrTaxNumberInvalid:         "Ogiltigt födelsedatum för kortinnehavaren eller ogiltigt organisationsnummer",
+	},
+	"ja": {
+		ErrCardNumberInvalid:        "有効なカード番号を入力してください",
+		ErrCardNumberEmpty:          "カード番号を入力してください",
+		ErrCardNumberUnsupported:    "対応しているカードブランドを入力してください",
+		ErrCardNumberIncomplete:     "カード番号をすべて入力してください",
+		ErrSecurityCodeEmpty:        "セキュリティコードを入力してください",
+		ErrSecurityCodeIncomplete:   "セキュリティコードをすべて入力してください",
+		ErrExpiryTooOld:             "カードの有効期限が切れています",
+		ErrExpiryTooFar:             "日付が先すぎます",
+		ErrExpiryEmpty:              "有効期限を入力してください",
+		ErrExpiryInvalid:            "有効期限をすべて入力してください",
+		ErrHolderNameInvalid:        "有効なカード名義人名を入力してください",
+		ErrFieldIncomplete:          "未入力の項目があります",
+		ErrFieldInvalid:             "項目が無効です",
+		ErrBankAccountNumberInvalid: "有効な口座番号を入力してください",
+		ErrBankLocationIDInvalid:    "有効なABAルーティング番号を入力してください",
+		ErrKCPPasswordInvalid:       "パスワードが無効です",
+		ErrTaxNumberInvalid:         "カード名義人の生年月日または法人登録番号が無効です",
+	},
+	"ko": {
+		ErrCardNumberInvalid:        "유효한 카드 번호를 입력하세요",
+		ErrCardNumberEmpty:          "카드 번호를 입력하세요",
+		ErrCardNumberUnsupported:    "지원되는 카드 브랜드를 입력하세요",
+		ErrCardNumberIncomplete:     "카드 번호를 모두 입력하세요",
+		ErrSecurityCodeEmpty:        "보안 코드를 입력하세요",
+		ErrSecurityCodeIncomplete:   "보안 코드를 모두 입력하세요",
+		ErrExpiryTooOld:             "카드가 만료되었습니다",
+		ErrExpiryTooFar:             "날짜가 너무 먼 미래입니다",
+		ErrExpiryEmpty:              "유효 기간을 입력하세요",
+		ErrExpiryInvalid:            "유효 기간을 모두 입력하세요",
+		ErrHolderNameInvalid:        "유효한 카드 소유자 이름을 입력하세요",
+		ErrFieldIncomplete:          "입력란이 완료되지 않았습니다",
+		ErrFieldInvalid:             "입력란이 올바르지 않습니다",
+		ErrBankAccountNumberInvalid: "유효한 계좌 번호를 입력하세요",
+		ErrBankLocationIDInvalid:    "유효한 ABA 라우팅 번호를 입력하세요",
+		ErrKCPPasswordInvalid:       "비밀번호가 올바르지 않습니다",
+		ErrTaxNumberInvalid:         "카드 소유자 생년월일 또는 사업자등록번호가 올바르지 않습니다",
+	},
+	"zh": {
+		ErrCardNumberInvalid:        "请输入有效的卡号",
+		ErrCardNumberEmpty:          "请输入卡号",
+		ErrCardNumberUnsupported:    "请输入支持的卡品牌",
+		ErrCardNumberIncomplete:     "请输入完整的卡号",
+		ErrSecurityCodeEmpty:        "请输入安全码",
+		ErrSecurityCodeIncomplete:   "请输入完整的安全码",
+		ErrExpiryTooOld:             "卡已过期",
+		ErrExpiryTooFar:             "日期过于遥远",
+		ErrExpiryEmpty:              "请输入有效期",
+		ErrExpiryInvalid:            "请输入完整的有效期",
+		ErrHolderNameInvalid:        "请输入有效的持卡人姓名",
+		ErrFieldIncomplete:          "字段不完整",
+		ErrFieldInvalid:             "字段无效",
+		ErrBankAccountNumberInvalid: "请输入有效的账号",
+		ErrBankLocationIDInvalid:    "请输入有效的 ABA 路由号码",
+		ErrKCPPasswordInvalid:       "密码无效",
+		ErrTaxNumberInvalid:         "持卡人出生日期或企业注册号无效",
+	},
+	"zh-hant": traditionalChineseMessages,
+	"zh-hk":   traditionalChineseMessages,
+	"zh-tw":   traditionalChineseMessages,
+	"ru": {
+		ErrCardNumberInvalid:        "Введите действительный номер карты",
+		ErrCardNumberEmpty:          "Введите номер карты",
+		ErrCardNumberUnsupported:    "Введите поддерживаемую платежную систему",
+		ErrCardNumberIncomplete:     "Введите номер карты полностью",
+		ErrSecurityCodeEmpty:        "Введите код безопасности",
+		ErrSecurityCodeIncomplete:   "Введите код безопасности полностью",
+		ErrExpiryTooOld:             "Срок действия карты истек",
+		ErrExpiryTooFar:             "Дата слишком далеко в будущем",
+		ErrExpiryEmpty:              "Введите срок действия",
+		ErrExpiryInvalid:            "Введите срок действия полностью",
+		ErrHolderNameInvalid:        "Введите действительное имя держателя карты",
+		ErrFieldIncomplete:          "Поле не заполнено",
+		ErrFieldInvalid:             "Поле заполнено неверно",
+		ErrBankAccountNumberInvalid: "Введите действительный номер счета",
+		ErrBankLocationIDInvalid:    "Введите действительный маршрутный номер ABA",
+		ErrKCPPasswordInvalid:       "Неверный пароль",
+		ErrTaxNumberInvalid:         "Неверная дата рождения держателя карты или регистрационный номер компании",
+	},
+}
+
+// traditionalChineseMessages holds the messages in Chinese
+// written with traditional characters.
+var traditionalChineseMessages = map[ErrorCode]string{
+	ErrCardNumberInvalid:        "請輸入有效的卡號",
+	ErrCardNumberEmpty:          "請輸入卡號",
+	ErrCardNumberUnsupported:    "請輸入支援的卡片品牌",
+	ErrCardNumberIncomplete:     "請輸入完整的卡號",
+	ErrSecurityCodeEmpty:        "請輸入安全碼",
+	ErrSecurityCodeIncomplete:   "請輸入完整的安全碼",
+	ErrExpiryTooOld:             "卡片已過期",
+	ErrExpiryTooFar:             "日期過於遙遠",
+	ErrExpiryEmpty:              "請輸入有效期限",
+	ErrExpiryInvalid:            "請輸入完整的有效期限",
+	ErrHolderNameInvalid:        "請輸入有效的持卡人姓名",
+	ErrFieldIncomplete:          "欄位不完整",
+	ErrFieldInvalid:             "欄位無效",
+	ErrBankAccountNumberInvalid: "請輸入有效的帳號",
+	ErrBankLocationIDInvalid:    "請輸入有效的 ABA 路由號碼",
+	ErrKCPPasswordInvalid:       "密碼無效",
+	ErrTaxNumberInvalid:         "持卡人出生日期或企業登記號碼無效",
+}
+
+// Message returns the shopper-facing message for c in the language of the
+// given BCP 47 language tag, like "en-US" or the value of BrowserInfo.Language.
+//
+// The English message is used if there is no message for the language,
+// and the code itself is returned for unknown codes.
+func (c ErrorCode) Message(locale string) string {
+	for _, tag := range localeFallbacks(locale) {
+		if msg, ok := messages[tag][c]; ok {
+			return msg
+		}
+	}
+	if msg, ok := messages["en"][c]; ok {
+		return msg
+	}
+	return string(c)
+}
+
+// Message returns the shopper-facing message for e in the language of the
+// given BCP 47 language tag. See ErrorCode.Message.
+func (e *ValidationError) Message(locale string) string {
+	return e.Code.Message(locale)
+}
@@ -0,0 +1,65 @@
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package adyen
+
+import "testing"
+
+func TestErrorCode_Message(t *testing.T) {
+	for code := range descriptions {
+		if _, ok := messages["en"][code]; !ok {
+			t.Errorf("no en message for %s\n", code)
+		}
+	}
+	for lang, m := range messages {
+		for code := range messages["en"] {
+			if _, ok := m[code]; !ok {
+				t.Errorf("no %s message for %s\n", lang, code)
+			}
+		}
+	}
+
+	test := func(code ErrorCode, locale, expected string) {
+		if msg := code.Message(locale); msg != expected {
+			t.Fatalf("(%s, %s) should be %q, instead got %q\n", code, locale, expected, msg)
+		}
+	}
+
+	test(ErrCardNumberInvalid, "en-US", "Enter a valid card number")
+	test(ErrCardNumberInvalid, "nl-NL", "Voer een geldig kaartnummer in")
+	test(ErrCardNumberInvalid, "pt_BR", "Insira um número de cartão válido")
+	test(ErrCardNumberInvalid, "zh-CN", "请输入有效的卡号")
+	test(ErrCardNumberInvalid, "zh-TW", "請輸入有效的卡號")
+	test(ErrCardNumberInvalid, "zh_Hant_HK", "請輸入有效的卡號")
+	test(ErrCardNumberInvalid, "zh-HK", "請輸入有效的卡號")
+	test(ErrCardNumberInvalid, "xx", "Enter a valid card number")
+	test(ErrCardNumberInvalid, "", "Enter a valid card number")
+	test("unknown", "en", "unknown")
+
+	info := BrowserInfo{Language: "de-DE"}
+	_, err := ValidateCardNumber("", "visa")
+	if msg := err.(*ValidationError).Message(info.Language); msg != "Geben Sie die Kartennummer ein" {
+		t.Fatalf("unexpected message %q\n", msg)
+	}
+}
@@ -0,0 +1,91 @@
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package adyen
+
+import (
+	"strings"
+	"unicode"
+)
+
+// NormalizeCardNumber turns a card number as typed or pasted by a shopper
+// into its digits.
+//
+// White space (including non-breaking spaces), dashes and dots are removed,
+// and Unicode decimal digits, like full-width digits, are replaced with
+// their ASCII equivalent. Card numbers that are empty or contain anything
+// else are rejected.
+//
+// If err != nil, it is a *ValidationError.
+func NormalizeCardNumber(number string) (string, error) {
+	digits, ok := normalizeDigits(number)
+	if !ok {
+		return "", &ValidationError{Field: KeyNumber, Code: ErrCardNumberInvalid}
+	}
+	if digits == "" {
+		return "", &ValidationError{Field: KeyNumber, Code: ErrCardNumberEmpty}
+	}
+	return digits, nil
+}
+
+// normalizeDigits normalizes s like NormalizeCardNumber and returns its digits,
+// which may be empty. It returns false if s contains anything else.
+func normalizeDigits(s string) (string, bool) {
+	if isDigits(s) {
+		return s, true
+	}
+
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range s {
+		switch {
+		case r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case unicode.IsDigit(r):
+			b.WriteByte('0' + byte(digitValue(r)))
+		case r == '.' || unicode.IsSpace(r) || unicode.Is(unicode.Pd, r):
+		default:
+			return "", false
+		}
+	}
+	return b.String(), true
+}
+
+// digitValue returns the value of the Unicode decimal digit r.
+//
+// Decimal digits always come in runs of ten code points from zero to nine,
+// so the value is the distance from the start of its range modulo ten.
+func digitValue(r rune) int {
+	for _, rng := range unicode.Nd.R16 {
+		if r >= rune(rng.Lo) && r <= rune(rng.Hi) {
+			return int(r-rune(rng.Lo)) % 10
+		}
+	}
+	for _, rng := range unicode.Nd.R32 {
+		if r >= rune(rng.Lo) && r <= rune(rng.Hi) {
+			return int(r-rune(rng.Lo)) % 10
+		}
+	}
+	return 0
+}
@@ -0,0 +1,65 @@
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package adyen
+
+import "testing"
+
+func TestNormalizeCardNumber(t *testing.T) {
+	test := func(number, expected string) {
+		if normalized, err := NormalizeCardNumber(number); err != nil || normalized != expected {
+			t.Fatalf("%q should be %s, instead got %q (%v)\n", number, expected, normalized, err)
+		}
+	}
+
+	test("5123459046058920", "5123459046058920")
+	test("5123 4590 4605 8920", "5123459046058920")
+	test("5123-4590-4605-8920", "5123459046058920")
+	test("5123.4590.4605.8920", "5123459046058920")
+	test("5123\t4590 4605–8920\n", "5123459046058920")
+	test("５１２３４５９０４６０５８９２０", "5123459046058920")
+	test("٥١٢٣", "5123")
+
+	for _, number := range []string{"", " - ", "5123x4590", "5123/4590", "٥١٢٣+"} {
+		if _, err := NormalizeCardNumber(number); err == nil {
+			t.Fatalf("%q should not normalize\n", number)
+		}
+	}
+}
+
+func TestNormalizeCardNumber_Usage(t *testing.T) {
+	const number = "５１２３-４５９０-４６０５-８９２０"
+
+	if DetectCardType(number) != "mc" {
+		t.Fail()
+	}
+
+	if FormatCardNumber(number) != "5123 4590 4605 8920" {
+		t.Fail()
+	}
+
+	if FormatCardNumber("5123x4590") != "5123x4590" {
+		t.Fail()
+	}
+}
@@ -0,0 +1,149 @@
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package adyen
+
+// PaymentMethodBCMC is the payment method type of Bancontact cards.
+const PaymentMethodBCMC = "bcmc"
+
+// A PaymentMethod is the "paymentMethod" object of a /payments request.
+// Each implementation marshals to the JSON that Adyen expects for its type.
+//
+// Read more: https://docs.adyen.com/api-explorer/Checkout/latest/post/payments#request-paymentMethod
+type PaymentMethod interface {
+	// PaymentMethodType returns the "type" of the payment method, like PaymentMethodScheme.
+	PaymentMethodType() string
+}
+
+var (
+	_ PaymentMethod = (*CardPaymentMethod)(nil)
+	_ PaymentMethod = (*StoredCardPaymentMethod)(nil)
+	_ PaymentMethod = (*GiftCardPaymentMethod)(nil)
+	_ PaymentMethod = (*ACHPaymentMethod)(nil)
+)
+
+// A CardPaymentMethod is the "paymentMethod" object for a card payment
+// with encrypted card fields, either with PaymentMethodScheme or PaymentMethodBCMC.
+type CardPaymentMethod struct {
+	// Type is PaymentMethodScheme or PaymentMethodBCMC.
+	Type string `json:"type"`
+
+	EncryptedCard
+
+	// HolderName is the optional name of the cardholder.
+	HolderName string `json:"holderName,omitempty"`
+
+	// Brand is the optional brand of the card, like "visa".
+	// It is used to pick a brand of dual-branded cards.
+	Brand string `json:"brand,omitempty"`
+
+	// KCPFields holds the extra fields of Korean cards, if any.
+	*KCPFields
+}
+
+// NewSchemePaymentMethod returns the payment method of a card payment with
+// the card fields encrypted by EncryptCardFields. The holder name and brand are optional.
+func NewSchemePaymentMethod(encrypted EncryptedCard, holderName, brand string) *CardPaymentMethod {
+	return &CardPaymentMethod{
+		Type:          PaymentMethodScheme,
+		EncryptedCard: encrypted,
+		HolderName:    holderName,
+		Brand:         brand,
+	}
+}
+
+// NewBCMCPaymentMethod returns the payment method of a Bancontact card payment
+// with the card fields encrypted by EncryptCardFields. The holder name is optional.
+//
+// Bancontact cards have no security code, so an encrypted security code is left out.
+func NewBCMCPaymentMethod(encrypted EncryptedCard, holderName string) *CardPaymentMethod {
+	encrypted.EncryptedSecurityCode = ""
+	return &CardPaymentMethod{
+		Type:          PaymentMethodBCMC,
+		EncryptedCard: encrypted,
+		HolderName:    holderName,
+	}
+}
+
+// PaymentMethodType implements PaymentMethod.
+func (pm *CardPaymentMethod) PaymentMethodType() string {
+	return pm.Type
+}
+
+// NewStoredCardPaymentMethod returns the payment method of a one-click payment
+// with a stored card. The brand and the security code encrypted with
+// EncryptField and KeySecurityCode are optional.
+//
+// Use EncryptStoredCard to validate and encrypt the security code as well.
+func NewStoredCardPaymentMethod(storedPaymentMethodID, brand, encryptedSecurityCode string) *StoredCardPaymentMethod {
+	return &StoredCardPaymentMethod{
+		Type:                  PaymentMethodScheme,
+		StoredPaymentMethodID: storedPaymentMethodID,
+		Brand:                 brand,
+		EncryptedSecurityCode: encryptedSecurityCode,
+	}
+}
+
+// PaymentMethodType implements PaymentMethod.
+func (pm *StoredCardPaymentMethod) PaymentMethodType() string {
+	return pm.Type
+}
+
+// NewGiftCardPaymentMethod returns the payment method of a gift card payment
+// with the gift card number and PIN encrypted with EncryptField.
+//
+// Use EncryptGiftCard to validate and encrypt the number and PIN as well.
+func NewGiftCardPaymentMethod(brand, encryptedCardNumber, encryptedSecurityCode string) *GiftCardPaymentMethod {
+	return &GiftCardPaymentMethod{
+		Type:                  PaymentMethodGiftCard,
+		Brand:                 brand,
+		EncryptedCardNumber:   encryptedCardNumber,
+		EncryptedSecurityCode: encryptedSecurityCode,
+	}
+}
+
+// PaymentMethodType implements PaymentMethod.
+func (pm *GiftCardPaymentMethod) PaymentMethodType() string {
+	return pm.Type
+}
+
+// NewACHPaymentMethod returns the payment method of an ACH Direct Debit payment
+// with the bank account and routing number encrypted with EncryptField.
+// The bank account type is optional.
+//
+// Use EncryptACH to validate and encrypt the bank account as well.
+func NewACHPaymentMethod(encryptedBankAccountNumber, encryptedBankLocationID, ownerName string, accountType BankAccountType) *ACHPaymentMethod {
+	return &ACHPaymentMethod{
+		Type:                       PaymentMethodACH,
+		EncryptedBankAccountNumber: encryptedBankAccountNumber,
+		EncryptedBankLocationID:    encryptedBankLocationID,
+		OwnerName:                  ownerName,
+		BankAccountType:            accountType,
+	}
+}
+
+// PaymentMethodType implements PaymentMethod.
+func (pm *ACHPaymentMethod) PaymentMethodType() string {
+	return pm.Type
+}
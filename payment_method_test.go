@@ -0,0 +1,77 @@
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package adyen
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPaymentMethod_JSON(t *testing.T) {
+	encrypted := EncryptedCard{
+		EncryptedCardNumber:   "number",
+		EncryptedExpiryMonth:  "month",
+		EncryptedExpiryYear:   "year",
+		EncryptedSecurityCode: "cvc",
+	}
+
+	kcp := NewSchemePaymentMethod(encrypted, "", "")
+	kcp.KCPFields = &KCPFields{EncryptedPassword: "password", TaxNumber: "900101"}
+
+	test := func(pm PaymentMethod, expectedType, expected string) {
+		if typ := pm.PaymentMethodType(); typ != expectedType {
+			t.Fatalf("expected type %q, instead got %q\n", expectedType, typ)
+		}
+
+		b, err := json.Marshal(pm)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != expected {
+			t.Fatalf("expected JSON:\n%s\ninstead got:\n%s\n", expected, b)
+		}
+	}
+
+	test(NewSchemePaymentMethod(encrypted, "John Smith", "visa"), "scheme",
+		`{"type":"scheme","encryptedCardNumber":"number","encryptedExpiryMonth":"month","encryptedExpiryYear":"year",`+
+			`"encryptedSecurityCode":"cvc","holderName":"John Smith","brand":"visa"}`)
+	test(NewSchemePaymentMethod(EncryptedCard{EncryptedCardNumber: "number", EncryptedExpiryMonth: "month", EncryptedExpiryYear: "year"}, "", ""), "scheme",
+		`{"type":"scheme","encryptedCardNumber":"number","encryptedExpiryMonth":"month","encryptedExpiryYear":"year"}`)
+	test(kcp, "scheme",
+		`{"type":"scheme","encryptedCardNumber":"number","encryptedExpiryMonth":"month","encryptedExpiryYear":"year",`+
+			`"encryptedSecurityCode":"cvc","encryptedPassword":"password","taxNumber":"900101"}`)
+	test(NewBCMCPaymentMethod(encrypted, "John Smith"), "bcmc",
+		`{"type":"bcmc","encryptedCardNumber":"number","encryptedExpiryMonth":"month","encryptedExpiryYear":"year","holderName":"John Smith"}`)
+	test(NewStoredCardPaymentMethod("8415718415172204", "visa", "cvc"), "scheme",
+		`{"type":"scheme","storedPaymentMethodId":"8415718415172204","brand":"visa","encryptedSecurityCode":"cvc"}`)
+	test(NewStoredCardPaymentMethod("8415718415172204", "", ""), "scheme",
+		`{"type":"scheme","storedPaymentMethodId":"8415718415172204"}`)
+	test(NewGiftCardPaymentMethod(GiftCardGivex, "number", "pin"), "giftcard",
+		`{"type":"giftcard","brand":"givex","encryptedCardNumber":"number","encryptedSecurityCode":"pin"}`)
+	test(NewACHPaymentMethod("account", "location", "John Smith", BankAccountSavings), "ach",
+		`{"type":"ach","encryptedBankAccountNumber":"account","encryptedBankLocationId":"location","ownerName":"John Smith","bankAccountType":"savings"}`)
+	test(NewACHPaymentMethod("account", "location", "John Smith", ""), "ach",
+		`{"type":"ach","encryptedBankAccountNumber":"account","encryptedBankLocationId":"location","ownerName":"John Smith"}`)
+}
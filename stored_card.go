@@ -0,0 +1,74 @@
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package adyen
+
+import "strings"
+
+// PaymentMethodScheme is the payment method type of card payments.
+const PaymentMethodScheme = "scheme"
+
+// A StoredCardPaymentMethod is the "paymentMethod" object for a one-click
+// payment with a stored card.
+type StoredCardPaymentMethod struct {
+	// Type is always PaymentMethodScheme.
+	Type string `json:"type"`
+
+	// StoredPaymentMethodID is the ID of the stored card as returned by Adyen.
+	StoredPaymentMethodID string `json:"storedPaymentMethodId"`
+
+	// Brand is the brand of the stored card, like "visa".
+	Brand string `json:"brand,omitempty"`
+
+	// EncryptedSecurityCode is empty if the security code is left out,
+	// either because the brand hides it or because it is optional and was not given.
+	EncryptedSecurityCode string `json:"encryptedSecurityCode,omitempty"`
+}
+
+// EncryptStoredCard encrypts the security code of a stored card and returns
+// the payment method to send to Adyen.
+//
+// The security code is validated with ValidateSecurityCode for the stored
+// card's brand and only encrypted if the brand's CVCPolicy allows it,
+// so it is never sent for brands with CVCHidden.
+func (enc *Encrypter) EncryptStoredCard(storedPaymentMethodID, brand, securityCode string) (*StoredCardPaymentMethod, error) {
+	storedPaymentMethodID = strings.TrimSpace(storedPaymentMethodID)
+	if storedPaymentMethodID == "" {
+		return nil, &ValidationError{Field: "storedPaymentMethodId", Code: ErrFieldIncomplete}
+	}
+	if err := ValidateSecurityCode(brand, securityCode); err != nil {
+		return nil, err
+	}
+
+	pm := NewStoredCardPaymentMethod(storedPaymentMethodID, brand, "")
+	if securityCode == "" || CVCPolicyFor(brand) == CVCHidden {
+		return pm, nil
+	}
+
+	var err error
+	if pm.EncryptedSecurityCode, err = enc.EncryptField(KeySecurityCode, securityCode); err != nil {
+		return nil, err
+	}
+	return pm, nil
+}
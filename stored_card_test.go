@@ -0,0 +1,69 @@
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package adyen
+
+import "testing"
+
+func TestEncrypter_EncryptStoredCard(t *testing.T) {
+	enc, key := newTestEncrypter(t)
+
+	pm, err := enc.EncryptStoredCard("8415718415172204", "amex", "7373")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fields := decrypt(t, key, pm.EncryptedSecurityCode); fields[KeySecurityCode] != "7373" || len(fields) != 2 {
+		t.Fatalf("unexpected fields %v\n", fields)
+	}
+
+	m := marshalFields(t, pm)
+	if m["type"] != "scheme" || m["storedPaymentMethodId"] != "8415718415172204" || m["brand"] != "amex" ||
+		m["encryptedSecurityCode"] == "" {
+		t.Fatalf("unexpected fields %v\n", m)
+	}
+
+	// the security code is never sent for brands that hide it.
+	if pm, err = enc.EncryptStoredCard("8415718415172204", "bcmc", "737"); err != nil {
+		t.Fatal(err)
+	} else if pm.EncryptedSecurityCode != "" {
+		t.Fatal("expected no security code for bcmc")
+	}
+
+	// the security code may be left out for brands where it is optional.
+	if pm, err = enc.EncryptStoredCard("8415718415172204", "maestro", ""); err != nil {
+		t.Fatal(err)
+	} else if pm.EncryptedSecurityCode != "" {
+		t.Fatal("expected no security code for maestro")
+	}
+
+	if _, err = enc.EncryptStoredCard("8415718415172204", "amex", "737"); err == nil {
+		t.Fatal("expected an error for a 3-digit amex security code")
+	}
+	if _, err = enc.EncryptStoredCard("8415718415172204", "visa", ""); err == nil {
+		t.Fatal("expected an error for an empty visa security code")
+	}
+	if _, err = enc.EncryptStoredCard(" ", "visa", "737"); err == nil {
+		t.Fatal("expected an error for an empty stored payment method ID")
+	}
+}
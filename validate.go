@@ -0,0 +1,222 @@
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package adyen
+
+import "strings"
+
+// An ErrorCode identifies why a value failed validation.
+// The codes are the same as the ones adyen-web uses for its translations.
+type ErrorCode string
+
+const (
+	// ErrCardNumberInvalid is used if the card number has too many digits
+	// or fails the Luhn check.
+	ErrCardNumberInvalid ErrorCode = "error.va.sf-cc-num.01"
+
+	// ErrCardNumberEmpty is used if the card number is empty.
+	ErrCardNumberEmpty ErrorCode = "error.va.sf-cc-num.02"
+
+	// ErrCardNumberUnsupported is used if the card's brand is not detected
+	// or is not supported.
+	ErrCardNumberUnsupported ErrorCode = "error.va.sf-cc-num.03"
+
+	// ErrCardNumberIncomplete is used if the card number is too short
+	// for its brand.
+	ErrCardNumberIncomplete ErrorCode = "error.va.sf-cc-num.04"
+
+	// ErrHolderNameInvalid is used if the cardholder name is not valid.
+	ErrHolderNameInvalid ErrorCode = "creditCard.holderName.invalid"
+
+	// ErrFieldIncomplete is used if a required field without its own
+	// ErrorCode for it is empty or incomplete.
+	ErrFieldIncomplete ErrorCode = "error.va.gen.01"
+
+	// ErrFieldInvalid is used if a field without its own ErrorCode
+	// for it has a value that is not valid.
+	ErrFieldInvalid ErrorCode = "error.va.gen.02"
+)
+
+// descriptions holds the English description of each ErrorCode.
+var descriptions = map[ErrorCode]string{
+	ErrCardNumberInvalid:     "invalid card number",
+	ErrCardNumberEmpty:       "empty card number",
+	ErrCardNumberUnsupported: "unsupported card brand",
+	ErrCardNumberIncomplete:  "incomplete card number",
+
+	ErrHolderNameInvalid: "invalid cardholder name",
+	ErrFieldIncomplete:   "incomplete field",
+	ErrFieldInvalid:      "invalid field",
+
+	ErrSecurityCodeEmpty:      "empty security code",
+	ErrSecurityCodeIncomplete: "incomplete security code",
+
+	ErrExpiryTooOld:  "card has expired",
+	ErrExpiryTooFar:  "expiry date too far in the future",
+	ErrExpiryEmpty:   "empty expiry date",
+	ErrExpiryInvalid: "invalid expiry date",
+
+	ErrBankAccountNumberInvalid: "invalid bank account number",
+	ErrBankLocationIDInvalid:    "invalid routing number",
+
+	ErrKCPPasswordInvalid: "invalid card password",
+	ErrTaxNumberInvalid:   "invalid tax number",
+}
+
+// String returns the English description of c.
+func (c ErrorCode) String() string {
+	if s, ok := descriptions[c]; ok {
+		return s
+	}
+	return string(c)
+}
+
+// A ValidationError is returned when a value fails validation.
+type ValidationError struct {
+	// Field is the key of the field that failed validation, like KeyNumber.
+	Field string
+
+	// Code is the reason that the field failed validation.
+	Code ErrorCode
+}
+
+// Error implements the error interface.
+func (e *ValidationError) Error() string {
+	return "adyen: " + e.Field + ": " + e.Code.String()
+}
+
+// ValidationErrors is a list of validation errors for different fields.
+type ValidationErrors []*ValidationError
+
+// Error implements the error interface.
+func (errs ValidationErrors) Error() string {
+	s := make([]string, len(errs))
+	for i, err := range errs {
+		s[i] = err.Error()
+	}
+	return strings.Join(s, "; ")
+}
+
+// append appends err to errs if it is a *ValidationError or ValidationErrors.
+// Other non-nil errors are not expected and cause a panic.
+func (errs ValidationErrors) append(err error) ValidationErrors {
+	switch err := err.(type) {
+	case nil:
+		return errs
+	case *ValidationError:
+		return append(errs, err)
+	case ValidationErrors:
+		return append(errs, err...)
+	default:
+		panic("adyen: unexpected error " + err.Error())
+	}
+}
+
+// noLuhnBrands are the brands whose card numbers do not use the Luhn algorithm.
+var noLuhnBrands = map[string]bool{
+	"uatp": true,
+}
+
+// ValidateCardNumber validates a card number and returns its detected brand.
+// The card number is normalized with NormalizeCardNumber first.
+//
+// The card number must have one of the brand's permitted lengths and pass
+// the Luhn check if the brand uses it. If brands are given, the brand is
+// detected among them with BrandTable.DetectAllowed, and the card number is
+// unsupported if none of them matches. A card number that is too long for
+// its brand is invalid, not unsupported.
+//
+// If err != nil, it is a *ValidationError.
+func ValidateCardNumber(number string, brands ...string) (brand string, err error) {
+	if number, err = NormalizeCardNumber(number); err != nil {
+		return NoBrand, err
+	}
+
+	if brand = Brands.DetectAllowed(number, brands...); brand == NoBrand {
+		// a number that is too long no longer matches its brand's pattern,
+		// so look for the brand of its longest prefix.
+		for l := len(number) - 1; l > 0 && brand == NoBrand; l-- {
+			brand = Brands.DetectAllowed(number[:l], brands...)
+		}
+		if brand != NoBrand {
+			return brand, &ValidationError{Field: KeyNumber, Code: ErrCardNumberInvalid}
+		}
+		return DetectCardType(number), &ValidationError{Field: KeyNumber, Code: ErrCardNumberUnsupported}
+	}
+	b, _ := Brands.Find(brand)
+
+	if !contains(b.PermittedLengths, len(number)) {
+		code := ErrCardNumberIncomplete
+		if len(number) > b.PermittedLengths[len(b.PermittedLengths)-1] {
+			code = ErrCardNumberInvalid
+		}
+		return brand, &ValidationError{Field: KeyNumber, Code: code}
+	}
+
+	if !noLuhnBrands[brand] && !luhnValid(number) {
+		return brand, &ValidationError{Field: KeyNumber, Code: ErrCardNumberInvalid}
+	}
+	return brand, nil
+}
+
+// luhnValid reports whether digits passes the Luhn check.
+func luhnValid(digits string) bool {
+	return luhnSum(digits)%10 == 0
+}
+
+// luhnSum returns the Luhn sum of digits, where the last digit is the check digit.
+func luhnSum(digits string) (sum int) {
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if double {
+			if d *= 2; d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return
+}
+
+// isDigits reports whether s is non-empty and only contains ASCII digits.
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// contains reports whether v is in s.
+func contains[T comparable](s []T, v T) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,84 @@
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package adyen
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateCardNumber(t *testing.T) {
+	test := func(number, expectedBrand string, expectedCode ErrorCode, brands ...string) {
+		brand, err := ValidateCardNumber(number, brands...)
+		if brand != expectedBrand {
+			t.Fatalf("%s should be %s, instead got %s\n", number, expectedBrand, brand)
+		}
+
+		if code := errorCode(t, err); code != expectedCode {
+			t.Fatalf("%s should have code %q, instead got %q\n", number, expectedCode, code)
+		}
+	}
+
+	test("5123459046058920", "mc", "")
+	test("5123 4590 4605 8920", "mc", "")
+	test("378282246310005", "amex", "")
+	test("135410014004955", "uatp", "")
+	test("135410014004956", "uatp", "")
+
+	test("", NoBrand, ErrCardNumberEmpty)
+	test("5123-4590-4605-8920", "mc", "")
+	test("5123x4590x4605x8920", NoBrand, ErrCardNumberInvalid)
+	test("5123459046058921", "mc", ErrCardNumberInvalid)
+	test("51234590460589", "mc", ErrCardNumberIncomplete)
+	test("0000000000000000", NoBrand, ErrCardNumberUnsupported)
+	test("5123459046058920", "mc", ErrCardNumberUnsupported, "visa", "amex")
+	test("51234590460589200000", "mc", ErrCardNumberInvalid)
+	test("41111111111111111111", "visa", ErrCardNumberInvalid)
+	test("41111111111111111111", "visa", ErrCardNumberInvalid, "visa", "mc")
+	test("3782822463100050", "amex", ErrCardNumberInvalid)
+	test("378282246310005", "amex", "", "visa", "amex")
+
+	// the brand is detected among the given brands only.
+	test("4871049999999910", "visa", "", "visa", "mc")
+	test("4131840000000003", "visa", "", "visa", "mc")
+	test("5895626746595650", "maestro", "")
+	test("4509030000000005", "visaalphabankbonus", "", "visa", "visaalphabankbonus")
+}
+
+// errorCode returns the Code of err, which must be nil or a *ValidationError.
+// An empty ErrorCode is returned if err is nil.
+func errorCode(t *testing.T, err error) ErrorCode {
+	t.Helper()
+
+	if err == nil {
+		return ""
+	}
+
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("unexpected error %v\n", err)
+	}
+	return verr.Code
+}
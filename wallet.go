@@ -0,0 +1,199 @@
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package adyen
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	// PaymentMethodApplePay is the payment method type of Apple Pay.
+	PaymentMethodApplePay = "applepay"
+
+	// PaymentMethodGooglePay is the payment method type of Google Pay.
+	PaymentMethodGooglePay = "googlepay"
+)
+
+// ErrInvalidWalletToken is returned, possibly wrapped, if an Apple Pay
+// or Google Pay token does not have the expected shape.
+var ErrInvalidWalletToken = errors.New("adyen: invalid wallet token")
+
+var (
+	_ PaymentMethod = (*ApplePayPaymentMethod)(nil)
+	_ PaymentMethod = (*GooglePayPaymentMethod)(nil)
+)
+
+// An ApplePayPaymentMethod is the "paymentMethod" object for an Apple Pay payment.
+type ApplePayPaymentMethod struct {
+	// Type is always PaymentMethodApplePay.
+	Type string `json:"type"`
+
+	// ApplePayToken is the base64-encoded "paymentData" of the Apple Pay payment token.
+	ApplePayToken string `json:"applePayToken"`
+}
+
+// applePayPaymentData is the "paymentData" of an Apple Pay payment token.
+//
+// Read more: https://developer.apple.com/documentation/passkit/apple_pay/payment_token_format_reference
+type applePayPaymentData struct {
+	Version   string `json:"version"`
+	Data      string `json:"data"`
+	Signature string `json:"signature"`
+	Header    struct {
+		EphemeralPublicKey string `json:"ephemeralPublicKey"`
+		WrappedKey         string `json:"wrappedKey"`
+		PublicKeyHash      string `json:"publicKeyHash"`
+		TransactionID      string `json:"transactionId"`
+	} `json:"header"`
+}
+
+// NewApplePayPaymentMethod returns the payment method of an Apple Pay payment.
+// The token is the base64-encoded "paymentData" object of the Apple Pay payment token.
+//
+// The token is checked to be a base64-encoded JSON object with a known version
+// and all fields that the version requires, but its signature is not verified.
+// If the token is invalid, the error wraps ErrInvalidWalletToken.
+func NewApplePayPaymentMethod(token string) (*ApplePayPaymentMethod, error) {
+	token = strings.TrimSpace(token)
+
+	b, err := base64.StdEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: Apple Pay token is not base64: %v", ErrInvalidWalletToken, err)
+	}
+
+	var data applePayPaymentData
+	if err = json.Unmarshal(b, &data); err != nil {
+		return nil, fmt.Errorf("%w: Apple Pay token is not a JSON object: %v", ErrInvalidWalletToken, err)
+	}
+
+	required := map[string]string{
+		"data":                 data.Data,
+		"signature":            data.Signature,
+		"header.publicKeyHash": data.Header.PublicKeyHash,
+		"header.transactionId": data.Header.TransactionID,
+	}
+	switch data.Version {
+	case "EC_v1":
+		required["header.ephemeralPublicKey"] = data.Header.EphemeralPublicKey
+	case "RSA_v1":
+		required["header.wrappedKey"] = data.Header.WrappedKey
+	default:
+		return nil, fmt.Errorf("%w: unknown Apple Pay token version %q", ErrInvalidWalletToken, data.Version)
+	}
+	if err = checkRequired("Apple Pay", required); err != nil {
+		return nil, err
+	}
+
+	return &ApplePayPaymentMethod{Type: PaymentMethodApplePay, ApplePayToken: token}, nil
+}
+
+// PaymentMethodType implements PaymentMethod.
+func (pm *ApplePayPaymentMethod) PaymentMethodType() string {
+	return pm.Type
+}
+
+// A GooglePayPaymentMethod is the "paymentMethod" object for a Google Pay payment.
+type GooglePayPaymentMethod struct {
+	// Type is always PaymentMethodGooglePay.
+	Type string `json:"type"`
+
+	// GooglePayToken is the JSON payment token of the Google Pay payment.
+	GooglePayToken string `json:"googlePayToken"`
+}
+
+// googlePayToken is a Google Pay payment token.
+//
+// Read more: https://developers.google.com/pay/api/web/guides/resources/payment-data-cryptography
+type googlePayToken struct {
+	ProtocolVersion        string `json:"protocolVersion"`
+	Signature              string `json:"signature"`
+	SignedMessage          string `json:"signedMessage"`
+	IntermediateSigningKey *struct {
+		SignedKey  string   `json:"signedKey"`
+		Signatures []string `json:"signatures"`
+	} `json:"intermediateSigningKey"`
+}
+
+// NewGooglePayPaymentMethod returns the payment method of a Google Pay payment.
+// The token is the "paymentMethodData.tokenizationData.token" value of the
+// Google Pay payment data, which is a JSON object.
+//
+// The token is checked to be a JSON object with a known protocol version
+// and all fields that the version requires, but its signature is not verified.
+// If the token is invalid, the error wraps ErrInvalidWalletToken.
+func NewGooglePayPaymentMethod(token string) (*GooglePayPaymentMethod, error) {
+	token = strings.TrimSpace(token)
+
+	var data googlePayToken
+	if err := json.Unmarshal([]byte(token), &data); err != nil {
+		return nil, fmt.Errorf("%w: Google Pay token is not a JSON object: %v", ErrInvalidWalletToken, err)
+	}
+
+	required := map[string]string{
+		"signature":     data.Signature,
+		"signedMessage": data.SignedMessage,
+	}
+	switch data.ProtocolVersion {
+	case "ECv1":
+	case "ECv2", "ECv2SigningOnly":
+		if data.IntermediateSigningKey == nil || len(data.IntermediateSigningKey.Signatures) == 0 {
+			return nil, fmt.Errorf("%w: Google Pay token has no intermediateSigningKey", ErrInvalidWalletToken)
+		}
+		required["intermediateSigningKey.signedKey"] = data.IntermediateSigningKey.SignedKey
+	default:
+		return nil, fmt.Errorf("%w: unknown Google Pay protocol version %q", ErrInvalidWalletToken, data.ProtocolVersion)
+	}
+	if err := checkRequired("Google Pay", required); err != nil {
+		return nil, err
+	}
+
+	return &GooglePayPaymentMethod{Type: PaymentMethodGooglePay, GooglePayToken: token}, nil
+}
+
+// PaymentMethodType implements PaymentMethod.
+func (pm *GooglePayPaymentMethod) PaymentMethodType() string {
+	return pm.Type
+}
+
+// checkRequired returns an error wrapping ErrInvalidWalletToken that lists the
+// empty fields of a wallet token, in alphabetical order.
+func checkRequired(wallet string, fields map[string]string) error {
+	var missing []string
+	for name, value := range fields {
+		if value == "" {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	sort.Strings(missing)
+	return fmt.Errorf("%w: %s token is missing %s", ErrInvalidWalletToken, wallet, strings.Join(missing, ", "))
+}
@@ -0,0 +1,124 @@
+/*
+ * MIT License
+ *
+ * Copyright (C) 2022 Crimson Technologies, LLC. All rights reserved.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package adyen
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestNewApplePayPaymentMethod(t *testing.T) {
+	encode := func(s string) string {
+		return base64.StdEncoding.EncodeToString([]byte(s))
+	}
+
+	test := func(token string, valid bool) {
+		pm, err := NewApplePayPaymentMethod(token)
+		if (err == nil) != valid {
+			t.Fatalf("%s should be valid: %t, instead got %v\n", token, valid, err)
+		}
+		if err != nil {
+			if !errors.Is(err, ErrInvalidWalletToken) {
+				t.Fatalf("%s: unexpected error %v\n", token, err)
+			}
+			return
+		}
+		if pm.PaymentMethodType() != PaymentMethodApplePay || pm.ApplePayToken != token {
+			t.Fatalf("unexpected payment method %+v\n", pm)
+		}
+	}
+
+	test(encode(`{"version":"EC_v1","data":"ZGF0YQ==","signature":"c2ln",`+
+		`"header":{"ephemeralPublicKey":"a2V5","publicKeyHash":"aGFzaA==","transactionId":"abc123"}}`), true)
+	test(encode(`{"version":"RSA_v1","data":"ZGF0YQ==","signature":"c2ln",`+
+		`"header":{"wrappedKey":"a2V5","publicKeyHash":"aGFzaA==","transactionId":"abc123"}}`), true)
+	test(encode(`{"version":"RSA_v1","data":"ZGF0YQ==","signature":"c2ln",`+
+		`"header":{"ephemeralPublicKey":"a2V5","publicKeyHash":"aGFzaA==","transactionId":"abc123"}}`), false)
+	test(encode(`{"version":"EC_v1","data":"ZGF0YQ==",`+
+		`"header":{"ephemeralPublicKey":"a2V5","publicKeyHash":"aGFzaA==","transactionId":"abc123"}}`), false)
+	test(encode(`{"version":"EC_v2","data":"ZGF0YQ==","signature":"c2ln",`+
+		`"header":{"ephemeralPublicKey":"a2V5","publicKeyHash":"aGFzaA==","transactionId":"abc123"}}`), false)
+	test(encode(`["EC_v1"]`), false)
+	test(`{"version":"EC_v1"}`, false)
+	test("", false)
+}
+
+func TestNewGooglePayPaymentMethod(t *testing.T) {
+	test := func(token string, valid bool) {
+		pm, err := NewGooglePayPaymentMethod(token)
+		if (err == nil) != valid {
+			t.Fatalf("%s should be valid: %t, instead got %v\n", token, valid, err)
+		}
+		if err != nil {
+			if !errors.Is(err, ErrInvalidWalletToken) {
+				t.Fatalf("%s: unexpected error %v\n", token, err)
+			}
+			return
+		}
+		if pm.PaymentMethodType() != PaymentMethodGooglePay || pm.GooglePayToken != token {
+			t.Fatalf("unexpected payment method %+v\n", pm)
+		}
+	}
+
+	test(`{"signature":"sig","intermediateSigningKey":{"signedKey":"key","signatures":["sig"]},`+
+		`"protocolVersion":"ECv2","signedMessage":"message"}`, true)
+	test(`{"signature":"sig","protocolVersion":"ECv1","signedMessage":"message"}`, true)
+	test(`{"signature":"sig","protocolVersion":"ECv2","signedMessage":"message"}`, false)
+	test(`{"signature":"sig","intermediateSigningKey":{"signedKey":"key","signatures":[]},`+
+		`"protocolVersion":"ECv2","signedMessage":"message"}`, false)
+	test(`{"signature":"sig","protocolVersion":"ECv1"}`, false)
+	test(`{"signature":"sig","protocolVersion":"ECv3","signedMessage":"message"}`, false)
+	test(`null`, false)
+	test("", false)
+}
+
+func TestWalletPaymentMethod_JSON(t *testing.T) {
+	token := `{"signature":"sig","protocolVersion":"ECv1","signedMessage":"message"}`
+	pm, err := NewGooglePayPaymentMethod(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := json.Marshal(struct {
+		PaymentMethod PaymentMethod `json:"paymentMethod"`
+		BrowserInfo   BrowserInfo   `json:"browserInfo"`
+	}{pm, BrowserInfo{Language: "en-US"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var m struct {
+		PaymentMethod map[string]string `json:"paymentMethod"`
+		BrowserInfo   BrowserInfo       `json:"browserInfo"`
+	}
+	if err = json.Unmarshal(b, &m); err != nil {
+		t.Fatal(err)
+	}
+	if m.PaymentMethod["type"] != "googlepay" || m.PaymentMethod["googlePayToken"] != token || m.BrowserInfo.Language != "en-US" {
+		t.Fatalf("unexpected JSON %s\n", b)
+	}
+}